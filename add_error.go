@@ -1,11 +1,21 @@
 package changeset
 
+import (
+	"github.com/go-rel/changeset/params"
+)
+
 // Error struct.
 type Error struct {
-	Message string `json:"message"`
-	Field   string `json:"field,omitempty"`
-	Code    int    `json:"code,omitempty"`
-	Err     error  `json:"-"`
+	Message string                 `json:"message"`
+	Field   string                 `json:"field,omitempty"`
+	Path    Path                   `json:"path,omitempty"`
+	Code    int                    `json:"code,omitempty"`
+	Row     int                    `json:"row,omitempty"`
+	Column  int                    `json:"column,omitempty"`
+	Key     string                 `json:"key,omitempty"`
+	Args    map[string]interface{} `json:"args,omitempty"`
+	Err     error                  `json:"-"`
+	custom  bool
 }
 
 // Error prints error message.
@@ -18,10 +28,75 @@ func (e Error) Unwrap() error {
 	return e.Err
 }
 
+// FieldPath returns path of the field that caused the error.
+// Errors added directly to a changeset have a single segment path of its field.
+func (e Error) FieldPath() Path {
+	if e.Path != nil {
+		return e.Path
+	}
+
+	if e.Field == "" {
+		return nil
+	}
+
+	return Path{e.Field}
+}
+
 // AddError adds an error to changeset.
+//
 //	ch := changeset.Cast(user, params, fields)
 //	changeset.AddError(ch, "field", "error")
 //	ch.Errors() // []errors.Error{{Field: "field", Message: "error"}}
 func AddError(ch *Changeset, field string, message string) {
-	ch.errors = append(ch.errors, Error{Message: message, Field: field})
+	ch.errors = append(ch.errors, position(ch, Error{Message: message, Field: field}))
+}
+
+// addError adds an error with message key and arguments, the message is rendered from template using the arguments.
+// field is always available as argument, source is available when params know where the field comes from, eg: Merged.
+// default messages only use field, source is rendered by custom message or translation that uses {source}.
+func addError(ch *Changeset, field string, key string, template string, args map[string]interface{}) {
+	ch.errors = append(ch.errors, newError(ch, field, key, template, args))
+}
+
+// addOptionError adds an error using message of options, message given using Message option is kept as is on translation.
+func addOptionError(ch *Changeset, field string, key string, options Options, args map[string]interface{}) {
+	e := newError(ch, field, key, options.message, args)
+	e.custom = options.customMessage
+	ch.errors = append(ch.errors, e)
+}
+
+func newError(ch *Changeset, field string, key string, template string, args map[string]interface{}) Error {
+	if args == nil {
+		args = make(map[string]interface{}, 1)
+	}
+
+	args["field"] = field
+
+	if s, ok := ch.params.(params.Sourcer); ok {
+		if source := s.Source(field); source != "" {
+			args["source"] = source
+		}
+	}
+
+	return position(ch, Error{
+		Message: formatMessage(template, args),
+		Field:   field,
+		Key:     key,
+		Args:    args,
+	})
+}
+
+// position sets row and column of the error when params of changeset know the location of the field, eg: CSVRow.
+func position(ch *Changeset, e Error) Error {
+	if e.Row != 0 {
+		return e
+	}
+
+	if p, ok := ch.params.(params.Positioner); ok {
+		if row, column, ok := p.Position(e.Field); ok {
+			e.Row, e.Column = row, column
+		}
+	}
+
+	return e
 }
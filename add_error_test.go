@@ -2,8 +2,10 @@ package changeset
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
+	"github.com/go-rel/changeset/params"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -34,3 +36,54 @@ func TestError_Unwrap(t *testing.T) {
 
 	assert.Equal(t, wrappedError, err.Unwrap())
 }
+
+func TestAddError_position(t *testing.T) {
+	r := params.NewCSVReader(strings.NewReader("name,location.city,location.coordinate.lat\nHome,,invalid\n"))
+	row, err := r.Read()
+	assert.Nil(t, err)
+
+	ch := Cast(Place{}, row, []string{"name"})
+	CastEmbed(ch, "location", changeLocation)
+	AddError(ch, "name", "name is taken")
+
+	assert.Equal(t, []error{
+		Error{
+			Message: "lat is invalid",
+			Field:   "location.coordinate.lat",
+			Path:    Path{"location", "coordinate", "lat"},
+			Row:     2,
+			Column:  7,
+			Key:     CastErrorKey,
+			Args:    map[string]interface{}{"field": "lat"},
+		},
+		Error{
+			Message: "city is required",
+			Field:   "location.city",
+			Path:    Path{"location", "city"},
+			Row:     2,
+			Column:  6,
+			Key:     ValidateRequiredErrorKey,
+			Args:    map[string]interface{}{"field": "city"},
+		},
+		Error{Message: "name is taken", Field: "name", Row: 2, Column: 1},
+	}, ch.Errors())
+}
+
+func TestAddError_source(t *testing.T) {
+	type User struct {
+		Name string
+		Age  int
+	}
+
+	input := params.Merge(
+		params.Named("query parameter", params.Map{"name": "Luffy", "age": "invalid"}),
+		params.Named("body field", params.Map{"name": true}),
+	)
+
+	ch := Cast(User{}, input, []string{"name", "age"}, Message("{source} `{field}` is invalid"))
+
+	assert.Equal(t, 2, len(ch.Errors()))
+	assert.Equal(t, "body field `name` is invalid", ch.Errors()[0].Error())
+	assert.Equal(t, "body field", ch.Errors()[0].(Error).Args["source"])
+	assert.Equal(t, "query parameter `age` is invalid", ch.Errors()[1].Error())
+}
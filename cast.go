@@ -3,7 +3,7 @@ package changeset
 import (
 	"math"
 	"reflect"
-	"strings"
+	"sort"
 
 	"github.com/go-rel/changeset/params"
 )
@@ -11,9 +11,25 @@ import (
 // CastErrorMessage is the default error message for Cast.
 var CastErrorMessage = "{field} is invalid"
 
+// CastErrorKey is the message key of Cast error.
+const CastErrorKey = "cast"
+
+// CastStrictErrorMessage is the default error message for Cast when params contains key that is not permitted in strict mode.
+var CastStrictErrorMessage = "{field} is not permitted"
+
+// CastStrictErrorKey is the message key of Cast strict error.
+const CastStrictErrorKey = "cast_strict"
+
+// CastNullErrorMessage is the default error message for Cast when null is sent to a field that can't be null.
+var CastNullErrorMessage = "{field} can't be null"
+
+// CastNullErrorKey is the message key of Cast null error.
+const CastNullErrorKey = "cast_null"
+
 // Cast params as changes for the given data according to the permitted fields. Returns a new changeset.
 // params will only be added as changes if it does not have the same value as the field in the data.
-func Cast(data interface{}, params params.Params, fields []string, opts ...Option) *Changeset {
+// Explicit null is casted as nil change, fields listed using NotNull option will have an error instead, absent params are ignored.
+func Cast(data interface{}, p params.Params, fields []string, opts ...Option) *Changeset {
 	options := Options{
 		message:     CastErrorMessage,
 		emptyValues: []interface{}{""},
@@ -27,38 +43,154 @@ func Cast(data interface{}, params params.Params, fields []string, opts ...Optio
 		ch = existingCh
 	} else {
 		ch = &Changeset{}
-		ch.params = params
+		ch.params = p
 		ch.changes = make(map[string]interface{})
 		ch.values, ch.types, ch.zero = mapSchema(data, true)
 	}
 
+	if ch.permitted == nil {
+		ch.permitted = make(map[string]bool, len(fields))
+	}
+
 	for _, field := range fields {
 		typ, texist := ch.types[field]
+		permitAssoc(ch, field)
 
-		if !params.Exists(field) || !texist {
+		if !p.Exists(field) || !texist {
 			continue
 		}
 
 		// ignore if it's an empty value
-		if contains(options.emptyValues, params.Get(field)) {
+		if contains(options.emptyValues, p.Get(field)) {
+			continue
+		}
+
+		if castNull(ch, p, field, field, options) {
 			continue
 		}
 
-		if change, valid := params.GetWithType(field, typ); valid {
+		if change, valid := params.GetWithConverters(p, field, typ, options.converters); valid {
 			value, vexist := ch.values[field]
 
-			if (typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array) || (ch.zero && change != nil) || (!vexist && change != nil) || (vexist && value != change) {
+			if (typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array) || (ch.zero && change != nil) || (!vexist && change != nil) || (vexist && !equal(value, change)) {
 				ch.changes[field] = change
 			}
 		} else {
-			msg := strings.Replace(options.message, "{field}", field, 1)
-			AddError(ch, field, msg)
+			addOptionError(ch, field, CastErrorKey, options, nil)
 		}
 	}
 
+	if options.strict {
+		enforceStrict(ch)
+	}
+
 	return ch
 }
 
+// strictErrors returns error for every params key that is not permitted in the changeset and its associations.
+// association that is already strict is skipped since its errors are already reported.
+func strictErrors(ch *Changeset) []error {
+	var result Changeset
+
+	if ch.params != nil {
+		for _, key := range ch.params.Keys() {
+			if !ch.permitted[key] {
+				addError(&result, key, CastStrictErrorKey, CastStrictErrorMessage, nil)
+			}
+		}
+	}
+
+	fields := make([]string, 0, len(ch.changes))
+	for field := range ch.changes {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		switch v := ch.changes[field].(type) {
+		case *Changeset:
+			if !v.strict {
+				mergeErrors(&result, &Changeset{errors: strictErrors(v)}, Path{field})
+			}
+		case []*Changeset:
+			for i := range v {
+				if !v[i].strict {
+					mergeErrors(&result, &Changeset{errors: strictErrors(v[i])}, Path{field, i})
+				}
+			}
+		}
+	}
+
+	return result.errors
+}
+
+// permitAssoc permits params key used by field or association, removing error previously added in strict mode or by patch.
+func permitAssoc(ch *Changeset, key string) {
+	if ch.permitted == nil {
+		ch.permitted = make(map[string]bool)
+	}
+
+	ch.permitted[key] = true
+
+	errs := ch.errors[:0]
+	for _, err := range ch.errors {
+		if e, ok := err.(Error); ok && e.Key == CastStrictErrorKey && e.Path == nil && e.Field == key {
+			continue
+		}
+
+		errs = append(errs, err)
+	}
+
+	if len(errs) == 0 {
+		errs = nil
+	}
+
+	ch.errors = errs
+}
+
+// permitKeys permits params keys used by association to match or delete it, empty key is ignored.
+func permitKeys(ch *Changeset, keys ...string) {
+	for _, key := range keys {
+		if key != "" {
+			permitAssoc(ch, key)
+		}
+	}
+}
+
+// strictAssoc checks params of association when its parent is strict.
+func strictAssoc(ch *Changeset, innerch *Changeset) {
+	if ch.strict {
+		enforceStrict(innerch)
+	}
+}
+
+// enforceStrict reports params key that is not permitted once, and checks association casted later.
+func enforceStrict(ch *Changeset) {
+	if !ch.strict {
+		ch.errors = append(ch.errors, strictErrors(ch)...)
+		ch.strict = true
+	}
+}
+
+// castNull casts explicit null param, returns false if the param is not null or params can't tell explicit null.
+func castNull(ch *Changeset, par params.Params, sourceField string, field string, options Options) bool {
+	if p, ok := par.(params.Presencer); !ok || p.Presence(sourceField) != params.Null {
+		return false
+	}
+
+	if containsString(options.notNull, field) {
+		addError(ch, field, CastNullErrorKey, CastNullErrorMessage, nil)
+		return true
+	}
+
+	// record nil change if it overrides existing value or previous change.
+	if _, changed := ch.changes[field]; changed || ch.values[field] != nil {
+		ch.changes[field] = nil
+	}
+
+	return true
+}
+
 func mapSchema(data interface{}, zero bool) (map[string]interface{}, map[string]reflect.Type, bool) {
 	var (
 		fields    = inferFields(data)
@@ -95,6 +227,25 @@ func contains(vs []interface{}, v interface{}) bool {
 	return false
 }
 
+// equal compares two values, uncomparable value such as big.Int is compared deeply.
+func equal(a interface{}, b interface{}) bool {
+	if a != nil && !reflect.TypeOf(a).Comparable() {
+		return reflect.DeepEqual(a, b)
+	}
+
+	return a == b
+}
+
+func containsString(vs []string, v string) bool {
+	for i := range vs {
+		if vs[i] == v {
+			return true
+		}
+	}
+
+	return false
+}
+
 // isZero shallowly check wether a field in struct is zero or not
 func isZero(i interface{}) bool {
 	zero := true
@@ -0,0 +1,170 @@
+package changeset
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-rel/changeset/params"
+)
+
+var validationRulesCache sync.Map
+
+type validationRules struct {
+	fields      []string
+	required    []string
+	validations []func(*Changeset)
+}
+
+// CastAndValidate casts params as changes using permitted fields and validates them using rules declared in struct tags.
+// A field is permitted when it has ch or validate tag, rules are declared after field name in ch tag or inside validate tag.
+//
+//	type User struct {
+//		Name  string `ch:"name,required,max=100"`
+//		Role  string `validate:"required,inclusion=admin|member"`
+//		Age   int    `validate:"range=17:60"`
+//		Email string `validate:"pattern=^\\S+@\\S+$"`
+//	}
+//
+// Supported rules are required, min=n, max=n, range=min:max, pattern=regexp, inclusion=a|b and exclusion=a|b.
+// Pattern can't contain comma because it's used as rule separator.
+func CastAndValidate(data interface{}, params params.Params, opts ...Option) *Changeset {
+	var (
+		rules = inferValidationRules(data)
+		ch    = Cast(data, params, rules.fields, opts...)
+	)
+
+	if len(rules.required) > 0 {
+		ValidateRequired(ch, rules.required)
+	}
+
+	for _, validate := range rules.validations {
+		validate(ch)
+	}
+
+	return ch
+}
+
+func inferValidationRules(record interface{}) validationRules {
+	rt := reflectTypePtr(record)
+
+	// check for cache
+	if v, cached := validationRulesCache.Load(rt); cached {
+		return v.(validationRules)
+	}
+
+	var (
+		rules  validationRules
+		fields = inferFields(record)
+		types  = inferTypes(record)
+	)
+
+	for i := 0; i < rt.NumField(); i++ {
+		var (
+			sf             = rt.Field(i)
+			name           = inferFieldName(sf)
+			chTag, hasCh   = sf.Tag.Lookup("ch")
+			valTag, hasVal = sf.Tag.Lookup("validate")
+			tags           []string
+		)
+
+		if name == "" || (!hasCh && !hasVal) {
+			continue
+		}
+
+		typ := types[fields[name]]
+		rules.fields = append(rules.fields, name)
+
+		if hasCh {
+			tags = strings.Split(chTag, ",")[1:]
+		}
+
+		if hasVal {
+			tags = append(tags, strings.Split(valTag, ",")...)
+		}
+
+		for _, tag := range tags {
+			switch tag {
+			case "":
+				continue
+			case "required":
+				rules.required = append(rules.required, name)
+			default:
+				rules.validations = append(rules.validations, compileValidationRule(name, typ, tag))
+			}
+		}
+	}
+
+	validationRulesCache.Store(rt, rules)
+
+	return rules
+}
+
+func compileValidationRule(field string, typ reflect.Type, rule string) func(*Changeset) {
+	name, arg := rule, ""
+	if i := strings.IndexByte(rule, '='); i >= 0 {
+		name, arg = rule[:i], rule[i+1:]
+	}
+
+	switch name {
+	case "min":
+		min := parseRuleInt(field, rule, arg)
+		return func(ch *Changeset) { ValidateMin(ch, field, min) }
+	case "max":
+		max := parseRuleInt(field, rule, arg)
+		return func(ch *Changeset) { ValidateMax(ch, field, max) }
+	case "range":
+		bounds := strings.SplitN(arg, ":", 2)
+		if len(bounds) != 2 {
+			panic("changeset: invalid validation rule " + rule + " for field " + field)
+		}
+
+		var (
+			min = parseRuleInt(field, rule, bounds[0])
+			max = parseRuleInt(field, rule, bounds[1])
+		)
+
+		return func(ch *Changeset) { ValidateRange(ch, field, min, max) }
+	case "pattern":
+		exp := regexp.MustCompile(arg)
+		return func(ch *Changeset) { ValidateRegexp(ch, field, exp) }
+	case "inclusion":
+		values := parseRuleValues(field, typ, rule, arg)
+		return func(ch *Changeset) { ValidateInclusion(ch, field, values) }
+	case "exclusion":
+		values := parseRuleValues(field, typ, rule, arg)
+		return func(ch *Changeset) { ValidateExclusion(ch, field, values) }
+	}
+
+	panic("changeset: unknown validation rule " + rule + " for field " + field)
+}
+
+func parseRuleInt(field string, rule string, arg string) int {
+	n, err := strconv.Atoi(arg)
+	if err != nil {
+		panic("changeset: invalid validation rule " + rule + " for field " + field)
+	}
+
+	return n
+}
+
+// parseRuleValues converts values of inclusion and exclusion rule to the type of the field.
+func parseRuleValues(field string, typ reflect.Type, rule string, arg string) []interface{} {
+	var (
+		strs   = strings.Split(arg, "|")
+		values = make([]interface{}, len(strs))
+	)
+
+	for i, str := range strs {
+		value, valid := params.Form{"value": {str}}.GetWithType("value", typ)
+		if !valid {
+			panic("changeset: invalid validation rule " + rule + " for field " + field)
+		}
+
+		values[i] = value
+	}
+
+	return values
+}
@@ -0,0 +1,115 @@
+package changeset
+
+import (
+	"testing"
+
+	"github.com/go-rel/changeset/params"
+	"github.com/stretchr/testify/assert"
+)
+
+type taggedUser struct {
+	ID     int
+	Name   string `ch:"name,required,max=10"`
+	Role   string `validate:"required,inclusion=admin|member"`
+	Level  int    `validate:"exclusion=0|13"`
+	Age    int    `validate:"range=17:60"`
+	Email  string `ch:"email_address" validate:"pattern=^\\S+@\\S+$"`
+	Nick   string `ch:",min=3"`
+	Secret string
+}
+
+func TestCastAndValidate(t *testing.T) {
+	input := params.Map{
+		"id":            1,
+		"name":          "Luffy",
+		"role":          "admin",
+		"level":         5,
+		"age":           19,
+		"email_address": "luffy@example.com",
+		"nick":          "strawhat",
+		"secret":        "meat",
+	}
+
+	ch := CastAndValidate(taggedUser{}, input)
+	assert.Nil(t, ch.Error())
+	assert.Equal(t, map[string]interface{}{
+		"name":          "Luffy",
+		"role":          "admin",
+		"level":         5,
+		"age":           19,
+		"email_address": "luffy@example.com",
+		"nick":          "strawhat",
+	}, ch.Changes())
+}
+
+func TestCastAndValidate_error(t *testing.T) {
+	input := params.Map{
+		"name":          "Monkey D. Luffy",
+		"role":          "captain",
+		"level":         13,
+		"age":           16,
+		"email_address": "luffy",
+		"nick":          "mo",
+	}
+
+	ch := CastAndValidate(&taggedUser{}, input)
+
+	var messages []string
+	for _, err := range ch.Errors() {
+		messages = append(messages, err.Error())
+	}
+
+	assert.Equal(t, []string{
+		"name must be less than 10",
+		"role must be one of [admin member]",
+		"level must not be any of [0 13]",
+		"age must be between 17 and 60",
+		"email_address's format is invalid",
+		"nick must be more than 3",
+	}, messages)
+
+	ch = CastAndValidate(taggedUser{}, params.Map{})
+	assert.Equal(t, 2, len(ch.Errors()))
+	assert.Equal(t, "name is required", ch.Errors()[0].Error())
+	assert.Equal(t, "role is required", ch.Errors()[1].Error())
+}
+
+func TestCastAndValidate_invalidRule(t *testing.T) {
+	tests := []struct {
+		name string
+		data interface{}
+	}{
+		{
+			name: "unknown",
+			data: struct {
+				Name string `validate:"unique"`
+			}{},
+		},
+		{
+			name: "max",
+			data: struct {
+				Name string `validate:"max=ten"`
+			}{},
+		},
+		{
+			name: "range",
+			data: struct {
+				Name string `validate:"range=10"`
+			}{},
+		},
+		{
+			name: "inclusion",
+			data: struct {
+				Age int `validate:"inclusion=1|two"`
+			}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Panics(t, func() {
+				CastAndValidate(tt.data, params.Map{})
+			})
+		})
+	}
+}
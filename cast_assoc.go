@@ -1,9 +1,8 @@
 package changeset
 
 import (
+	"errors"
 	"reflect"
-	"strconv"
-	"strings"
 
 	"github.com/go-rel/changeset/params"
 )
@@ -11,13 +10,52 @@ import (
 // CastAssocErrorMessage is the default error message for CastAssoc when its invalid.
 var CastAssocErrorMessage = "{field} is invalid"
 
+// CastAssocErrorKey is the message key of CastAssoc error.
+const CastAssocErrorKey = "cast_assoc"
+
 // CastAssocRequiredMessage is the default error message for CastAssoc when its missing.
 var CastAssocRequiredMessage = "{field} is required"
 
+// CastAssocRequiredKey is the message key of CastAssoc when its missing error.
+const CastAssocRequiredKey = "cast_assoc_required"
+
+// CastAssocReplaceErrorMessage is the default error message for CastAssoc when existing association is replaced using ReplaceError policy.
+var CastAssocReplaceErrorMessage = "{field} cannot be replaced"
+
+// CastAssocReplaceErrorKey is the message key of CastAssoc when existing association is replaced error.
+const CastAssocReplaceErrorKey = "cast_assoc_replace"
+
+// CastAssocDeleteErrorMessage is the default error message for CastAssoc when single association is marked using DeleteField.
+var CastAssocDeleteErrorMessage = "{field} cannot be deleted"
+
+// CastAssocDeleteErrorKey is the message key of CastAssoc when single association is marked to be deleted error.
+const CastAssocDeleteErrorKey = "cast_assoc_delete"
+
+// CastLimitErrorMessage is the default error message for CastAssoc and CastEmbed when its params exceeds the limits.
+var CastLimitErrorMessage = "{field} is too large"
+
+// CastLimitErrorKey is the message key of CastAssoc and CastEmbed limit error.
+const CastLimitErrorKey = "cast_limit"
+
+// ReplacePolicy defines what happens to existing has many association that is not present in params.
+type ReplacePolicy int
+
+const (
+	// ReplaceDelete deletes replaced association, this is the default policy.
+	ReplaceDelete ReplacePolicy = iota
+	// ReplaceKeep keeps replaced association as is.
+	ReplaceKeep
+	// ReplaceError adds an error to changeset when any association is replaced.
+	ReplaceError
+)
+
 // ChangeFunc is changeset function.
 type ChangeFunc func(interface{}, params.Params) *Changeset
 
 // CastAssoc casts association changes using changeset function.
+// Params of has many association are matched to existing association using primary key,
+// matched association is updated while the rest is inserted.
+// Existing association that is not present in params is handled according to OnReplace option.
 // Repo insert or update won't persist any changes generated by CastAssoc.
 func CastAssoc(ch *Changeset, field string, fn ChangeFunc, opts ...Option) {
 	options := Options{
@@ -30,35 +68,44 @@ func CastAssoc(ch *Changeset, field string, fn ChangeFunc, opts ...Option) {
 		sourceField = field
 	}
 
+	permitAssoc(ch, sourceField)
+
 	typ, texist := ch.types[field]
 	valid := true
 	if texist && ch.params.Exists(sourceField) {
+		if !checkLimits(ch, sourceField, field, options) {
+			return
+		}
+
 		if typ.Kind() == reflect.Struct {
-			valid = castOne(ch, sourceField, field, fn)
+			valid = castOne(ch, sourceField, field, fn, options)
 		} else if typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.Struct {
-			valid = castMany(ch, sourceField, field, fn)
+			valid = castMany(ch, sourceField, field, fn, options)
 		}
 	}
 
 	if !valid {
-		msg := strings.Replace(options.message, "{field}", field, 1)
-		AddError(ch, field, msg)
+		addOptionError(ch, field, CastAssocErrorKey, options, nil)
 	}
 
 	_, found := ch.changes[field]
 	if options.required && !found {
-		options.message = CastAssocRequiredMessage
-		msg := strings.Replace(options.message, "{field}", field, 1)
-		AddError(ch, field, msg)
+		addError(ch, field, CastAssocRequiredKey, CastAssocRequiredMessage, nil)
 	}
 }
 
-func castOne(ch *Changeset, fieldSource string, fieldTarget string, fn ChangeFunc) bool {
+func castOne(ch *Changeset, fieldSource string, fieldTarget string, fn ChangeFunc, options Options) bool {
 	par, valid := ch.params.GetParams(fieldSource)
 	if !valid {
 		return false
 	}
 
+	// repository only deletes has many association, deleting single association would be silently ignored.
+	if deleteMarked(par, options.deleteField) {
+		addError(ch, fieldTarget, CastAssocDeleteErrorKey, CastAssocDeleteErrorMessage, nil)
+		return true
+	}
+
 	var innerch *Changeset
 
 	if val, exist := ch.values[fieldTarget]; exist && val != nil {
@@ -68,37 +115,185 @@ func castOne(ch *Changeset, fieldSource string, fieldTarget string, fn ChangeFun
 	}
 
 	ch.changes[fieldTarget] = innerch
+	permitKeys(innerch, options.deleteField)
+	strictAssoc(ch, innerch)
 
 	// add errors to main errors
-	mergeErrors(ch, innerch, fieldTarget+".")
+	mergeErrors(ch, innerch, Path{fieldTarget})
 
 	return true
 }
 
-func castMany(ch *Changeset, fieldSource string, fieldTarget string, fn ChangeFunc) bool {
+func castMany(ch *Changeset, fieldSource string, fieldTarget string, fn ChangeFunc, options Options) bool {
 	spar, valid := ch.params.GetParamsSlice(fieldSource)
 	if !valid {
 		return false
 	}
 
-	data := reflect.Zero(ch.types[fieldTarget].Elem()).Interface()
+	var (
+		typ      = ch.types[fieldTarget].Elem()
+		data     = reflect.Zero(typ).Interface()
+		key      = options.primaryKey
+		existing = make(map[interface{}]interface{})
+		keyType  reflect.Type
+	)
+
+	if key == "" {
+		key = inferPrimaryField(typ)
+	}
+
+	if key != "" {
+		keyType = indexAssocMany(existing, ch.values[fieldTarget], typ, key)
+	}
 
 	chs := make([]*Changeset, len(spar))
 	for i, par := range spar {
-		innerch := fn(data, par)
+		var innerch *Changeset
+
+		elem, matched := matchAssoc(existing, par, key, keyType)
+
+		switch {
+		case deleteMarked(par, options.deleteField):
+			if matched {
+				innerch = Change(elem)
+			} else {
+				// association is not loaded, it can only be deleted using its primary key.
+				if keyType == nil || key != inferPrimaryField(typ) {
+					return false
+				}
+
+				value, _ := par.GetWithType(key, keyType)
+				if value == nil || isZero(value) {
+					return false
+				}
+
+				innerch = Change(data)
+				innerch.values[key] = value
+			}
+
+			innerch.deleted = true
+		case matched:
+			innerch = fn(elem, par)
+		default:
+			innerch = fn(data, par)
+		}
+
 		chs[i] = innerch
+		permitKeys(innerch, key, options.deleteField)
+		strictAssoc(ch, innerch)
 
 		// add errors to main errors
-		mergeErrors(ch, innerch, fieldTarget+"["+strconv.Itoa(i)+"].")
+		mergeErrors(ch, innerch, Path{fieldTarget, i})
 	}
 	ch.changes[fieldTarget] = chs
 
+	if options.onReplace != ReplaceDelete {
+		if ch.onReplace == nil {
+			ch.onReplace = make(map[string]ReplacePolicy)
+		}
+
+		ch.onReplace[fieldTarget] = options.onReplace
+	}
+
+	if options.onReplace == ReplaceError && len(existing) > 0 {
+		addError(ch, fieldTarget, CastAssocReplaceErrorKey, CastAssocReplaceErrorMessage, nil)
+	}
+
 	return true
 }
 
-func mergeErrors(parent *Changeset, child *Changeset, prefix string) {
+// indexAssocMany indexes existing has many association by its key, returns type of the key.
+// association with zero key is not indexed since it can't be matched.
+func indexAssocMany(existing map[interface{}]interface{}, assocs interface{}, typ reflect.Type, key string) reflect.Type {
+	var (
+		zero      = reflect.Zero(typ).Interface()
+		index, ok = inferFields(zero)[key]
+		rv        = reflect.ValueOf(assocs)
+	)
+
+	if !ok {
+		return nil
+	}
+
+	for i := 0; rv.IsValid() && i < rv.Len(); i++ {
+		elem := rv.Index(i)
+		if elem.Kind() == reflect.Ptr {
+			if elem.IsNil() {
+				continue
+			}
+
+			elem = elem.Elem()
+		}
+
+		data := elem.Interface()
+		if value := inferValues(data)[index]; value != nil && !isZero(value) {
+			existing[value] = data
+		}
+	}
+
+	return inferTypes(zero)[index]
+}
+
+// matchAssoc finds and removes existing association that matches key in params.
+func matchAssoc(existing map[interface{}]interface{}, par params.Params, key string, keyType reflect.Type) (interface{}, bool) {
+	if len(existing) == 0 || keyType == nil || !par.Exists(key) {
+		return nil, false
+	}
+
+	value, valid := par.GetWithType(key, keyType)
+	if !valid || value == nil {
+		return nil, false
+	}
+
+	elem, matched := existing[value]
+	if matched {
+		delete(existing, value)
+	}
+
+	return elem, matched
+}
+
+// checkLimits adds error when association params exceeds the limits, the error wraps params.LimitError.
+func checkLimits(ch *Changeset, sourceField string, field string, options Options) bool {
+	if options.limits == (params.Limits{}) {
+		return true
+	}
+
+	err := options.limits.CheckField(ch.params, sourceField)
+	if err == nil {
+		return true
+	}
+
+	args := map[string]interface{}{}
+
+	var limitErr params.LimitError
+	if errors.As(err, &limitErr) {
+		args["limit"] = limitErr.Limit
+	}
+
+	e := newError(ch, field, CastLimitErrorKey, CastLimitErrorMessage, args)
+	e.Err = err
+	ch.errors = append(ch.errors, e)
+
+	return false
+}
+
+// mergeErrors adds errors of child changeset to parent, prefixing their path while keeping code and wrapped error.
+func mergeErrors(parent *Changeset, child *Changeset, prefix Path) {
 	for _, err := range child.errors {
-		e := err.(Error)
-		AddError(parent, prefix+e.Field, e.Message)
+		e := toError(err)
+		e.Path = append(prefix[:len(prefix):len(prefix)], e.FieldPath()...)
+		e.Field = e.Path.String()
+		parent.errors = append(parent.errors, position(parent, e))
+	}
+}
+
+// deleteMarked returns true if params contains truthy delete field.
+func deleteMarked(par params.Params, deleteField string) bool {
+	if deleteField == "" || !par.Exists(deleteField) {
+		return false
 	}
+
+	value, valid := par.GetWithType(deleteField, reflect.TypeOf(true))
+	return valid && value == true
 }
@@ -1,6 +1,7 @@
 package changeset
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -458,3 +459,351 @@ func TestCastAssoc_optionRequired(t *testing.T) {
 	assert.NotNil(t, invalidCh.Errors())
 	assert.Nil(t, validCh.Errors())
 }
+
+func TestCastAssoc_strict(t *testing.T) {
+	var (
+		data struct {
+			Field1 int
+			Field2 Inner
+			Field3 []Inner
+		}
+		changeInner = func(data interface{}, input params.Params) *Changeset {
+			return Cast(data, input, []string{"field4"})
+		}
+		input = params.Map{
+			"field1": 1,
+			"field2": params.Map{"field4": 4, "field5": "5"},
+			"field3": []params.Map{
+				{"field4": 4},
+				{"field4": 4, "field6": 6},
+			},
+			"unknown": true,
+		}
+	)
+
+	ch := Cast(data, input, []string{"field1"}, Strict())
+	CastAssoc(ch, "field2", changeInner)
+	CastAssoc(ch, "field3", changeInner)
+
+	assert.Equal(t, []string{"unknown", "field2.field5", "field3[1].field6"}, errorFields(ch.Errors()))
+	assert.Equal(t, CastStrictErrorKey, ch.Errors()[2].(Error).Key)
+	assert.Equal(t, Path{"field3", 1, "field6"}, ch.Errors()[2].(Error).Path)
+}
+
+func TestCastAssoc_strictInner(t *testing.T) {
+	var (
+		data struct {
+			Field1 int
+			Field2 Inner
+		}
+		changeInner = func(data interface{}, input params.Params) *Changeset {
+			return Cast(data, input, []string{"field4"}, Strict())
+		}
+		input = params.Map{
+			"field1":  1,
+			"field2":  params.Map{"field4": 4, "field5": "5"},
+			"unknown": true,
+		}
+	)
+
+	ch := Cast(data, input, []string{"field1"}, Strict())
+	CastAssoc(ch, "field2", changeInner)
+
+	assert.Equal(t, []string{"unknown", "field2.field5"}, errorFields(ch.Errors()))
+}
+
+func TestCastAssoc_strictUpsertAndDelete(t *testing.T) {
+	var (
+		data = struct {
+			Field2 InnerWithID
+			Field3 []InnerWithID
+		}{
+			Field2: InnerWithID{ID: 2},
+			Field3: []InnerWithID{{ID: 5}, {ID: 6}},
+		}
+		input = params.Map{
+			"field2": params.Map{"field4": 2, "_delete": false},
+			"field3": []params.Map{
+				{"id": 5, "field4": 4, "unknown": true},
+				{"id": 6, "_delete": false},
+				{"id": 7, "_delete": true},
+			},
+		}
+	)
+
+	for _, strictInner := range []bool{false, true} {
+		changeInner := func(data interface{}, input params.Params) *Changeset {
+			if strictInner {
+				return Cast(data, input, []string{"field4"}, Strict())
+			}
+
+			return Cast(data, input, []string{"field4"})
+		}
+
+		ch := Cast(data, input, []string{}, Strict())
+		CastAssoc(ch, "field2", changeInner, DeleteField("_delete"))
+		CastAssoc(ch, "field3", changeInner, DeleteField("_delete"))
+
+		assert.Equal(t, []string{"field3[0].unknown"}, errorFields(ch.Errors()))
+	}
+}
+
+func TestCastAssoc_limit(t *testing.T) {
+	var (
+		data struct {
+			Field1 int
+			Field2 Inner
+			Field3 []Inner
+		}
+		changeInner = func(data interface{}, input params.Params) *Changeset {
+			return Cast(data, input, []string{"field4", "field5"})
+		}
+		input = params.Map{
+			"field2": params.Map{"field4": 4, "field5": "too long"},
+			"field3": []params.Map{{"field4": 4}, {"field4": 5}, {"field4": 6}},
+		}
+		limits = params.Limits{MaxArrayLength: 2, MaxStringLength: 5}
+	)
+
+	ch := Cast(data, input, []string{"field1"})
+	CastAssoc(ch, "field2", changeInner, Limit(limits))
+	CastAssoc(ch, "field3", changeInner, Limit(limits))
+
+	assert.Equal(t, []error{
+		Error{
+			Message: "field2 is too large",
+			Field:   "field2",
+			Key:     CastLimitErrorKey,
+			Args:    map[string]interface{}{"field": "field2", "limit": params.LimitStringLength},
+			Err:     params.LimitError{Limit: params.LimitStringLength, Max: 5, Key: "field2.field5"},
+		},
+		Error{
+			Message: "field3 is too large",
+			Field:   "field3",
+			Key:     CastLimitErrorKey,
+			Args:    map[string]interface{}{"field": "field3", "limit": params.LimitArrayLength},
+			Err:     params.LimitError{Limit: params.LimitArrayLength, Max: 2, Key: "field3"},
+		},
+	}, ch.Errors())
+	assert.False(t, ch.Changed("field2"))
+	assert.False(t, ch.Changed("field3"))
+
+	var limitErr params.LimitError
+	assert.True(t, errors.As(ch.Error(), &limitErr))
+
+	ch = Cast(data, input, []string{"field1"})
+	CastAssoc(ch, "field3", changeInner, Limit(params.Limits{MaxArrayLength: 3}))
+	assert.Nil(t, ch.Errors())
+	assert.True(t, ch.Changed("field3"))
+}
+
+func errorFields(errs []error) []string {
+	fields := make([]string, len(errs))
+	for i := range errs {
+		fields[i] = errs[i].(Error).Field
+	}
+
+	return fields
+}
+
+type InnerWithID struct {
+	ID     int
+	Field4 int
+	Field5 string
+}
+
+func TestCastAssoc_manyMatchExisting(t *testing.T) {
+	var (
+		data = struct {
+			Field3 []InnerWithID
+		}{
+			Field3: []InnerWithID{
+				{ID: 1, Field4: 14, Field5: "15"},
+				{ID: 2, Field4: 24, Field5: "25"},
+			},
+		}
+		changeInner = func(data interface{}, input params.Params) *Changeset {
+			return Cast(data, input, []string{"field4", "field5"})
+		}
+		input = params.Map{
+			"field3": []params.Map{
+				{"id": 2, "field5": "26"},
+				{"id": 3, "field4": 34},
+				{"field4": 44},
+			},
+		}
+		field3 = input["field3"].([]params.Map)
+	)
+
+	ch := Cast(data, input, []string{})
+	CastAssoc(ch, "field3", changeInner)
+
+	assert.Nil(t, ch.Errors())
+	assert.Nil(t, ch.onReplace)
+	assert.Equal(t, []*Changeset{
+		withPermitted(changeInner(data.Field3[1], field3[0]), "id"),
+		withPermitted(changeInner(InnerWithID{}, field3[1]), "id"),
+		withPermitted(changeInner(InnerWithID{}, field3[2]), "id"),
+	}, ch.Get("field3"))
+}
+
+func TestCastAssoc_manyMatchExistingPointer(t *testing.T) {
+	var (
+		data = struct {
+			Field3 []*InnerWithID
+		}{
+			Field3: []*InnerWithID{nil, {ID: 1, Field4: 14}},
+		}
+		changeInner = func(data interface{}, input params.Params) *Changeset {
+			return Cast(data, input, []string{"field4", "field5"})
+		}
+		input = params.Map{
+			"field3": []params.Map{
+				{"id": 1, "field5": "15"},
+			},
+		}
+	)
+
+	ch := Cast(data, input, []string{})
+	CastAssoc(ch, "field3", changeInner)
+
+	assert.Nil(t, ch.Errors())
+	assert.Equal(t, []*Changeset{
+		withPermitted(changeInner(*data.Field3[1], input["field3"].([]params.Map)[0]), "id"),
+	}, ch.Get("field3"))
+}
+
+func TestCastAssoc_manyPrimaryKey(t *testing.T) {
+	var (
+		data = struct {
+			Field3 []Inner
+		}{
+			Field3: []Inner{{Field4: 14, Field5: "15"}},
+		}
+		changeInner = func(data interface{}, input params.Params) *Changeset {
+			return Cast(data, input, []string{"field4"})
+		}
+		input = params.Map{
+			"field3": []params.Map{
+				{"field5": "15", "field4": 16},
+			},
+		}
+	)
+
+	ch := Cast(data, input, []string{})
+	CastAssoc(ch, "field3", changeInner, PrimaryKey("field5"))
+
+	assert.Nil(t, ch.Errors())
+	assert.Equal(t, []*Changeset{
+		withPermitted(changeInner(data.Field3[0], input["field3"].([]params.Map)[0]), "field5"),
+	}, ch.Get("field3"))
+}
+
+func TestCastAssoc_manyOnReplace(t *testing.T) {
+	var (
+		data = struct {
+			Field3 []InnerWithID
+		}{
+			Field3: []InnerWithID{{ID: 1}, {ID: 2}},
+		}
+		changeInner = func(data interface{}, input params.Params) *Changeset {
+			return Cast(data, input, []string{"field4"})
+		}
+		input = params.Map{
+			"field3": []params.Map{
+				{"id": 1, "field4": 14},
+			},
+		}
+	)
+
+	ch := Cast(data, input, []string{})
+	CastAssoc(ch, "field3", changeInner, OnReplace(ReplaceKeep))
+	assert.Nil(t, ch.Errors())
+	assert.Equal(t, map[string]ReplacePolicy{"field3": ReplaceKeep}, ch.onReplace)
+
+	ch = Cast(data, input, []string{})
+	CastAssoc(ch, "field3", changeInner, OnReplace(ReplaceError))
+	assert.Equal(t, "field3 cannot be replaced", ch.Error().Error())
+
+	// nothing is replaced.
+	input["field3"] = []params.Map{{"id": 1}, {"id": 2}}
+	ch = Cast(data, input, []string{})
+	CastAssoc(ch, "field3", changeInner, OnReplace(ReplaceError))
+	assert.Nil(t, ch.Errors())
+}
+
+func TestCastAssoc_deleteField(t *testing.T) {
+	var (
+		data = struct {
+			Field3 []InnerWithID
+			Field6 InnerWithID
+		}{
+			Field3: []InnerWithID{{ID: 1, Field4: 14}, {ID: 2, Field4: 24}},
+			Field6: InnerWithID{ID: 6},
+		}
+		changeInner = func(data interface{}, input params.Params) *Changeset {
+			return Cast(data, input, []string{"field4"})
+		}
+		input = params.Map{
+			"field3": []params.Map{
+				{"id": 2, "_delete": true},
+				{"id": 3, "_delete": true},
+				{"id": 1, "field4": 15, "_delete": false},
+			},
+			"field6": params.Map{
+				"_delete": true,
+			},
+		}
+		field3 = input["field3"].([]params.Map)
+	)
+
+	ch := Cast(data, input, []string{})
+	CastAssoc(ch, "field3", changeInner, DeleteField("_delete"))
+	CastAssoc(ch, "field6", changeInner, DeleteField("_delete"))
+
+	deleted2 := Change(data.Field3[1])
+	deleted2.deleted = true
+
+	deleted3 := Change(InnerWithID{})
+	deleted3.values["id"] = 3
+	deleted3.deleted = true
+
+	assert.Equal(t, []error{Error{
+		Message: "field6 cannot be deleted",
+		Field:   "field6",
+		Key:     CastAssocDeleteErrorKey,
+		Args:    map[string]interface{}{"field": "field6"},
+	}}, ch.Errors())
+	assert.Equal(t, []*Changeset{
+		withPermitted(deleted2, "id", "_delete"),
+		withPermitted(deleted3, "id", "_delete"),
+		withPermitted(changeInner(data.Field3[0], field3[2]), "id", "_delete"),
+	}, ch.Get("field3"))
+	assert.Nil(t, ch.Get("field6"))
+}
+
+func TestCastAssoc_deleteFieldWithoutKey(t *testing.T) {
+	var (
+		data struct {
+			Field3 []InnerWithID
+		}
+		changeInner = func(data interface{}, input params.Params) *Changeset {
+			return Cast(data, input, []string{"field4"})
+		}
+		input = params.Map{
+			"field3": []params.Map{
+				{"_delete": true},
+			},
+		}
+	)
+
+	ch := Cast(data, input, []string{})
+	CastAssoc(ch, "field3", changeInner, DeleteField("_delete"))
+	assert.Equal(t, "field3 is invalid", ch.Error().Error())
+}
+
+// withPermitted permits keys used by association to match or delete it.
+func withPermitted(ch *Changeset, keys ...string) *Changeset {
+	permitKeys(ch, keys...)
+	return ch
+}
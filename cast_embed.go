@@ -0,0 +1,119 @@
+package changeset
+
+import (
+	"reflect"
+)
+
+// CastEmbedErrorMessage is the default error message for CastEmbed when its invalid.
+var CastEmbedErrorMessage = "{field} is invalid"
+
+// CastEmbedErrorKey is the message key of CastEmbed error.
+const CastEmbedErrorKey = "cast_embed"
+
+// CastEmbedRequiredMessage is the default error message for CastEmbed when its missing.
+var CastEmbedRequiredMessage = "{field} is required"
+
+// CastEmbedRequiredKey is the message key of CastEmbed when its missing error.
+const CastEmbedRequiredKey = "cast_embed_required"
+
+// CastEmbed casts embedded struct or slice of struct, usually stored as json column, using changeset function.
+// Unlike CastAssoc, embedded changes are applied as a single field value instead of association mutation.
+// Embedded struct is casted on top of its existing value, while embedded slice is always replaced as a whole.
+func CastEmbed(ch *Changeset, field string, fn ChangeFunc, opts ...Option) {
+	options := Options{
+		message: CastEmbedErrorMessage,
+	}
+	options.apply(opts)
+
+	sourceField := options.sourceField
+	if sourceField == "" {
+		sourceField = field
+	}
+
+	permitAssoc(ch, sourceField)
+
+	typ, texist := ch.types[field]
+	valid := true
+	if texist && ch.params.Exists(sourceField) {
+		if !checkLimits(ch, sourceField, field, options) {
+			return
+		}
+
+		if typ.Kind() == reflect.Struct {
+			valid = castEmbedOne(ch, sourceField, field, fn, options)
+		} else if typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.Struct {
+			valid = castEmbedMany(ch, sourceField, field, fn, options)
+		}
+	}
+
+	if !valid {
+		addOptionError(ch, field, CastEmbedErrorKey, options, nil)
+	}
+
+	_, found := ch.changes[field]
+	if options.required && !found {
+		addError(ch, field, CastEmbedRequiredKey, CastEmbedRequiredMessage, nil)
+	}
+}
+
+func castEmbedOne(ch *Changeset, fieldSource string, fieldTarget string, fn ChangeFunc, options Options) bool {
+	markEmbed(ch, fieldTarget)
+	if castNull(ch, ch.params, fieldSource, fieldTarget, options) {
+		return true
+	}
+
+	par, valid := ch.params.GetParams(fieldSource)
+	if !valid {
+		return false
+	}
+
+	data := reflect.Zero(ch.types[fieldTarget]).Interface()
+	if val, exist := ch.values[fieldTarget]; exist && val != nil {
+		data = val
+	}
+
+	innerch := fn(data, par)
+	ch.changes[fieldTarget] = innerch
+	strictAssoc(ch, innerch)
+
+	// add errors to main errors
+	mergeErrors(ch, innerch, Path{fieldTarget})
+
+	return true
+}
+
+func castEmbedMany(ch *Changeset, fieldSource string, fieldTarget string, fn ChangeFunc, options Options) bool {
+	markEmbed(ch, fieldTarget)
+	if castNull(ch, ch.params, fieldSource, fieldTarget, options) {
+		return true
+	}
+
+	spar, valid := ch.params.GetParamsSlice(fieldSource)
+	if !valid {
+		return false
+	}
+
+	var (
+		data = reflect.Zero(ch.types[fieldTarget].Elem()).Interface()
+		chs  = make([]*Changeset, len(spar))
+	)
+
+	for i, par := range spar {
+		chs[i] = fn(data, par)
+		strictAssoc(ch, chs[i])
+
+		// add errors to main errors
+		mergeErrors(ch, chs[i], Path{fieldTarget, i})
+	}
+	ch.changes[fieldTarget] = chs
+
+	return true
+}
+
+func markEmbed(ch *Changeset, field string) {
+	if ch.embeds == nil {
+		ch.embeds = make(map[string]bool)
+	}
+
+	ch.embeds[field] = true
+}
@@ -0,0 +1,214 @@
+package changeset
+
+import (
+	"testing"
+
+	"github.com/go-rel/changeset/params"
+	"github.com/go-rel/rel"
+	"github.com/stretchr/testify/assert"
+)
+
+type Coordinate struct {
+	Lat float64
+	Lng float64
+}
+
+type Location struct {
+	Street     string
+	City       string
+	Coordinate *Coordinate
+}
+
+type Place struct {
+	ID        int
+	Name      string
+	Location  Location
+	Previous  *Location
+	Locations []Location
+	Histories []*Location
+}
+
+func changeCoordinate(data interface{}, input params.Params) *Changeset {
+	return Cast(data, input, []string{"lat", "lng"})
+}
+
+func changeLocation(data interface{}, input params.Params) *Changeset {
+	ch := Cast(data, input, []string{"street", "city"})
+	CastEmbed(ch, "coordinate", changeCoordinate)
+	ValidateRequired(ch, []string{"city"})
+	return ch
+}
+
+func TestCastEmbed_one(t *testing.T) {
+	var (
+		place = Place{ID: 1, Location: Location{Street: "Old Street", City: "Bandung"}}
+		input = params.Map{
+			"location": params.Map{
+				"street":     "Grove Street",
+				"coordinate": params.Map{"lat": 1.5, "lng": 2.5},
+			},
+		}
+	)
+
+	ch := Cast(place, input, []string{"name"})
+	CastEmbed(ch, "location", changeLocation)
+	assert.Nil(t, ch.Errors())
+	assert.True(t, ch.Changed("location"))
+
+	doc := rel.NewDocument(&place)
+	mut := rel.Apply(doc, ch)
+
+	expected := Location{Street: "Grove Street", City: "Bandung", Coordinate: &Coordinate{Lat: 1.5, Lng: 2.5}}
+	assert.Equal(t, expected, place.Location)
+	assert.Equal(t, rel.Set("location", expected), mut.Mutates["location"])
+	assert.Nil(t, mut.Assoc)
+}
+
+func TestCastEmbed_onePointer(t *testing.T) {
+	var (
+		place = Place{ID: 1}
+		input = params.Map{
+			"previous": params.Map{"street": "Grove Street", "city": "Bandung"},
+		}
+	)
+
+	ch := Cast(place, input, []string{"name"})
+	CastEmbed(ch, "previous", changeLocation)
+	assert.Nil(t, ch.Errors())
+
+	rel.Apply(rel.NewDocument(&place), ch)
+	assert.Equal(t, &Location{Street: "Grove Street", City: "Bandung"}, place.Previous)
+}
+
+func TestCastEmbed_oneNull(t *testing.T) {
+	var (
+		place = Place{ID: 1, Previous: &Location{City: "Bandung"}}
+		input = params.ParseJSON(`{"previous": null, "location": null}`)
+	)
+
+	ch := Cast(place, input, []string{"name"})
+	CastEmbed(ch, "previous", changeLocation)
+	assert.Nil(t, ch.Errors())
+
+	mut := rel.Apply(rel.NewDocument(&place), ch)
+	assert.Nil(t, place.Previous)
+	assert.Equal(t, rel.Set("previous", nil), mut.Mutates["previous"])
+
+	CastEmbed(ch, "location", changeLocation, NotNull("location"))
+	assert.Equal(t, []error{Error{
+		Message: "location can't be null",
+		Field:   "location",
+		Key:     CastNullErrorKey,
+		Args:    map[string]interface{}{"field": "location"},
+	}}, ch.Errors())
+}
+
+func TestCastEmbed_many(t *testing.T) {
+	var (
+		place = Place{ID: 1, Locations: []Location{{City: "Bandung"}}}
+		input = params.Map{
+			"locations": []params.Map{
+				{"city": "Jakarta"},
+				{"city": "Surabaya", "coordinate": params.Map{"lat": 1.0}},
+			},
+			"histories": []params.Map{
+				{"city": "Medan"},
+			},
+		}
+	)
+
+	ch := Cast(place, input, []string{"name"})
+	CastEmbed(ch, "locations", changeLocation)
+	CastEmbed(ch, "histories", changeLocation)
+	assert.Nil(t, ch.Errors())
+
+	mut := rel.Apply(rel.NewDocument(&place), ch)
+
+	expected := []Location{{City: "Jakarta"}, {City: "Surabaya", Coordinate: &Coordinate{Lat: 1}}}
+	assert.Equal(t, expected, place.Locations)
+	assert.Equal(t, []*Location{{City: "Medan"}}, place.Histories)
+	assert.Equal(t, rel.Set("locations", expected), mut.Mutates["locations"])
+	assert.Nil(t, mut.Assoc)
+}
+
+func TestCastEmbed_innerChangesetError(t *testing.T) {
+	input := params.Map{
+		"location": params.Map{"street": "Grove Street"},
+		"locations": []params.Map{
+			{"city": "Jakarta"},
+			{"coordinate": params.Map{"lat": "invalid"}},
+		},
+	}
+
+	ch := Cast(Place{}, input, []string{"name"})
+	CastEmbed(ch, "location", changeLocation)
+	CastEmbed(ch, "locations", changeLocation)
+
+	assert.Equal(t, 3, len(ch.Errors()))
+	assert.Equal(t, "location.city", ch.Errors()[0].(Error).Field)
+	assert.Equal(t, "locations[1].coordinate.lat", ch.Errors()[1].(Error).Field)
+	assert.Equal(t, "locations[1].city", ch.Errors()[2].(Error).Field)
+	assert.Equal(t, 2, len(ch.ErrorsFor(Path{"locations", 1})))
+}
+
+func TestCastEmbed_invalid(t *testing.T) {
+	input := params.Map{
+		"location":  "invalid",
+		"locations": "invalid",
+	}
+
+	ch := Cast(Place{}, input, []string{"name"})
+	CastEmbed(ch, "location", changeLocation)
+	CastEmbed(ch, "locations", changeLocation, Message("invalid locations"))
+
+	assert.Equal(t, 2, len(ch.Errors()))
+	assert.Equal(t, "location is invalid", ch.Errors()[0].Error())
+	assert.Equal(t, CastEmbedErrorKey, ch.Errors()[0].(Error).Key)
+	assert.Equal(t, "invalid locations", ch.Errors()[1].Error())
+}
+
+func TestCastEmbed_optionRequired(t *testing.T) {
+	ch := Cast(Place{}, params.Map{}, []string{"name"})
+	CastEmbed(ch, "location", changeLocation, Required(true))
+
+	assert.Equal(t, 1, len(ch.Errors()))
+	assert.Equal(t, "location is required", ch.Error().Error())
+	assert.Equal(t, CastEmbedRequiredKey, ch.Error().(Error).Key)
+}
+
+func TestCastEmbed_strict(t *testing.T) {
+	input := params.Map{
+		"name": "Home",
+		"location": params.Map{
+			"city":       "Bandung",
+			"country":    "Indonesia",
+			"coordinate": params.Map{"lat": 1.0, "alt": 2.0},
+		},
+	}
+
+	ch := Cast(Place{}, input, []string{"name"}, Strict())
+	CastEmbed(ch, "location", changeLocation)
+
+	assert.Equal(t, []string{"location.country", "location.coordinate.alt"}, errorFields(ch.Errors()))
+}
+
+func TestCastEmbed_limit(t *testing.T) {
+	input := params.Map{
+		"location": params.Map{
+			"city":       "Bandung",
+			"coordinate": params.Map{"lat": 1.0},
+		},
+	}
+
+	ch := Cast(Place{}, input, []string{"name"})
+	CastEmbed(ch, "location", changeLocation, Limit(params.Limits{MaxDepth: 1}))
+
+	assert.Equal(t, 1, len(ch.Errors()))
+	assert.Equal(t, CastLimitErrorKey, ch.Error().(Error).Key)
+	assert.Equal(t, params.LimitError{Limit: params.LimitDepth, Max: 1, Key: "location.city"}, ch.Error().(Error).Err)
+	assert.False(t, ch.Changed("location"))
+
+	ch = Cast(Place{}, input, []string{"name"})
+	CastEmbed(ch, "location", changeLocation, Limit(params.Limits{MaxDepth: 3}))
+	assert.Nil(t, ch.Errors())
+}
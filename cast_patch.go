@@ -0,0 +1,165 @@
+package changeset
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+
+	"github.com/go-rel/changeset/params"
+)
+
+// CastPatchErrorMessage is the default error message for CastJSONPatch when an operation can't be applied.
+var CastPatchErrorMessage = "{field} can't be patched"
+
+// CastPatchErrorKey is the message key of CastJSONPatch error.
+const CastPatchErrorKey = "cast_patch"
+
+// CastPatchMalformedMessage is the default error message for CastMergePatch and CastJSONPatch when patch document is malformed.
+var CastPatchMalformedMessage = "patch is malformed"
+
+// CastPatchMalformedKey is the message key of malformed patch error.
+const CastPatchMalformedKey = "cast_patch_malformed"
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	driverValuerType  = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+)
+
+// CastMergePatch casts JSON Merge Patch (RFC 7396) document as changes for the given data according to the permitted fields.
+// Patch member that is not permitted is reported as error, nested patch can be casted using CastAssoc or CastEmbed
+// and its members are checked the same way.
+func CastMergePatch(data interface{}, patch []byte, fields []string, opts ...Option) *Changeset {
+	p, err := params.ParseMergePatch(patch)
+	return castPatch(data, p, err, fields, opts)
+}
+
+// CastJSONPatch applies JSON Patch (RFC 6902) document to the given data, and casts the affected fields as changes
+// according to the permitted fields. Operation that addresses field which is not permitted is reported as error,
+// nested fields can be casted using CastAssoc or CastEmbed and are checked the same way,
+// and invalid operation is reported as error located at its path.
+func CastJSONPatch(data interface{}, patch []byte, fields []string, opts ...Option) *Changeset {
+	p, err := params.ApplyJSONPatch(patchDocument(data), patch)
+	return castPatch(data, p, err, fields, opts)
+}
+
+func castPatch(data interface{}, p params.Params, err error, fields []string, opts []Option) *Changeset {
+	if err != nil {
+		p = params.Map{}
+	}
+
+	ch := Cast(data, p, fields, opts...)
+
+	var patchErr params.PatchError
+	if errors.As(err, &patchErr) {
+		addPatchError(ch, patchErr.Path)
+	} else if err != nil {
+		addError(ch, "", CastPatchMalformedKey, CastPatchMalformedMessage, nil)
+	}
+
+	// every patched member must be permitted, associations casted later are checked the same way.
+	enforceStrict(ch)
+
+	return ch
+}
+
+// addPatchError adds error located at json pointer of the failed operation.
+func addPatchError(ch *Changeset, pointer string) {
+	var (
+		tokens, _ = params.ParsePointer(pointer)
+		path      = make(Path, len(tokens))
+	)
+
+	for i, token := range tokens {
+		if index, err := strconv.Atoi(token); err == nil && i > 0 {
+			path[i] = index
+		} else {
+			path[i] = token
+		}
+	}
+
+	e := newError(ch, path.String(), CastPatchErrorKey, CastPatchErrorMessage, nil)
+	if len(path) > 1 {
+		e.Path = path
+	}
+
+	ch.errors = append(ch.errors, e)
+}
+
+// patchDocument returns fields of data keyed by its changeset field name, nested struct is converted the same way.
+func patchDocument(data interface{}) map[string]interface{} {
+	var (
+		fields = inferFields(data)
+		values = inferValues(data)
+		doc    = make(map[string]interface{}, len(fields))
+	)
+
+	for field, index := range fields {
+		doc[field] = patchValue(reflect.ValueOf(values[index]))
+	}
+
+	return doc
+}
+
+func patchValue(rv reflect.Value) interface{} {
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+
+		rv = rv.Elem()
+	}
+
+	if !rv.IsValid() {
+		return nil
+	}
+
+	rt := rv.Type()
+	if rt.Implements(jsonMarshalerType) || rt.Implements(textMarshalerType) {
+		return rv.Interface()
+	}
+
+	if ptr := reflect.PtrTo(rt); ptr.Implements(jsonMarshalerType) || ptr.Implements(textMarshalerType) {
+		pv := reflect.New(rt)
+		pv.Elem().Set(rv)
+		return pv.Interface()
+	}
+
+	if rt.Implements(driverValuerType) {
+		if value, err := rv.Interface().(driver.Valuer).Value(); err == nil {
+			return value
+		}
+	}
+
+	switch rt.Kind() {
+	case reflect.Struct:
+		return patchDocument(rv.Interface())
+	case reflect.Slice, reflect.Array:
+		if rt.Elem().Kind() == reflect.Uint8 {
+			return rv.Interface()
+		}
+
+		result := make([]interface{}, rv.Len())
+		for i := range result {
+			result[i] = patchValue(rv.Index(i))
+		}
+
+		return result
+	case reflect.Map:
+		if rt.Key().Kind() != reflect.String {
+			return rv.Interface()
+		}
+
+		result := make(map[string]interface{}, rv.Len())
+		for _, key := range rv.MapKeys() {
+			result[key.String()] = patchValue(rv.MapIndex(key))
+		}
+
+		return result
+	}
+
+	return rv.Interface()
+}
@@ -0,0 +1,179 @@
+package changeset
+
+import (
+	"testing"
+
+	"github.com/go-rel/rel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCastMergePatch(t *testing.T) {
+	place := Place{ID: 1, Name: "Home", Location: Location{Street: "Old Street", City: "Bandung"}}
+
+	ch := CastMergePatch(place, []byte(`{"id": 2, "name": "Office", "location": {"street": "Grove Street"}}`), []string{"name"})
+	CastEmbed(ch, "location", changeLocation)
+
+	assert.Equal(t, []error{Error{
+		Message: "id is not permitted",
+		Field:   "id",
+		Key:     CastStrictErrorKey,
+		Args:    map[string]interface{}{"field": "id"},
+	}}, ch.Errors())
+
+	rel.Apply(rel.NewDocument(&place), ch)
+	assert.Equal(t, Place{ID: 1, Name: "Office", Location: Location{Street: "Grove Street", City: "Bandung"}}, place)
+}
+
+func TestCastMergePatch_null(t *testing.T) {
+	place := Place{ID: 1, Name: "Home", Previous: &Location{City: "Bandung"}}
+
+	ch := CastMergePatch(place, []byte(`{"previous": null, "name": null}`), []string{"name"}, NotNull("name"))
+	CastEmbed(ch, "previous", changeLocation)
+
+	assert.Equal(t, 1, len(ch.Errors()))
+	assert.Equal(t, CastNullErrorKey, ch.Error().(Error).Key)
+	assert.Equal(t, "name", ch.Error().(Error).Field)
+	assert.True(t, ch.Changed("previous"))
+	assert.Nil(t, ch.changes["previous"])
+}
+
+func TestCastMergePatch_malformed(t *testing.T) {
+	ch := CastMergePatch(Place{}, []byte(`[{"name": "Home"}]`), []string{"name"})
+
+	assert.Equal(t, []error{Error{
+		Message: "patch is malformed",
+		Key:     CastPatchMalformedKey,
+		Args:    map[string]interface{}{"field": ""},
+	}}, ch.Errors())
+	assert.Equal(t, 0, len(ch.Changes()))
+}
+
+func TestCastJSONPatch(t *testing.T) {
+	place := Place{
+		ID:        1,
+		Name:      "Home",
+		Location:  Location{Street: "Old Street", City: "Bandung", Coordinate: &Coordinate{Lat: 1, Lng: 2}},
+		Previous:  &Location{City: "Jakarta"},
+		Locations: []Location{{City: "Medan"}, {City: "Surabaya"}},
+	}
+
+	ch := CastJSONPatch(place, []byte(`[
+		{"op": "test", "path": "/location/coordinate/lat", "value": 1},
+		{"op": "replace", "path": "/name", "value": "Office"},
+		{"op": "replace", "path": "/location/city", "value": "Bogor"},
+		{"op": "remove", "path": "/previous"},
+		{"op": "remove", "path": "/locations/0"},
+		{"op": "add", "path": "/locations/-", "value": {"city": "Malang"}}
+	]`), []string{"name"})
+	CastEmbed(ch, "location", changeLocation)
+	CastEmbed(ch, "previous", changeLocation)
+	CastEmbed(ch, "locations", changeLocation)
+
+	assert.Nil(t, ch.Errors())
+
+	rel.Apply(rel.NewDocument(&place), ch)
+	assert.Equal(t, Place{
+		ID:        1,
+		Name:      "Office",
+		Location:  Location{Street: "Old Street", City: "Bogor", Coordinate: &Coordinate{Lat: 1, Lng: 2}},
+		Locations: []Location{{City: "Surabaya"}, {City: "Malang"}},
+	}, place)
+}
+
+func TestCastJSONPatch_unpermitted(t *testing.T) {
+	ch := CastJSONPatch(Place{ID: 1}, []byte(`[
+		{"op": "replace", "path": "/id", "value": 2},
+		{"op": "replace", "path": "/name", "value": "Office"}
+	]`), []string{"name"})
+
+	assert.Equal(t, []error{Error{
+		Message: "id is not permitted",
+		Field:   "id",
+		Key:     CastStrictErrorKey,
+		Args:    map[string]interface{}{"field": "id"},
+	}}, ch.Errors())
+	assert.Equal(t, map[string]interface{}{"name": "Office"}, ch.Changes())
+}
+
+func TestCastJSONPatch_unpermittedNested(t *testing.T) {
+	place := Place{ID: 1, Location: Location{City: "Bandung"}}
+
+	ch := CastJSONPatch(place, []byte(`[
+		{"op": "replace", "path": "/location/city", "value": "Bogor"},
+		{"op": "add", "path": "/location/zip", "value": "16111"}
+	]`), []string{"name"})
+	CastEmbed(ch, "location", changeLocation)
+
+	assert.Equal(t, []error{Error{
+		Message: "zip is not permitted",
+		Field:   "location.zip",
+		Path:    Path{"location", "zip"},
+		Key:     CastStrictErrorKey,
+		Args:    map[string]interface{}{"field": "zip"},
+	}}, ch.Errors())
+
+	ch = CastMergePatch(place, []byte(`{"location": {"city": "Bogor", "zip": "16111"}}`), []string{"name"})
+	CastEmbed(ch, "location", changeLocation)
+
+	assert.Equal(t, []string{"location.zip"}, errorFields(ch.Errors()))
+}
+
+func TestCastJSONPatch_invalidOperation(t *testing.T) {
+	place := Place{ID: 1, Location: Location{City: "Bandung"}, Locations: []Location{{City: "Medan"}}}
+
+	tests := []struct {
+		name  string
+		patch string
+		err   Error
+	}{
+		{
+			name:  "test failed",
+			patch: `[{"op": "test", "path": "/location/city", "value": "Jakarta"}]`,
+			err: Error{
+				Message: "location.city can't be patched",
+				Field:   "location.city",
+				Path:    Path{"location", "city"},
+				Key:     CastPatchErrorKey,
+				Args:    map[string]interface{}{"field": "location.city"},
+			},
+		},
+		{
+			name:  "index out of range",
+			patch: `[{"op": "replace", "path": "/locations/1/city", "value": "Jakarta"}]`,
+			err: Error{
+				Message: "locations[1].city can't be patched",
+				Field:   "locations[1].city",
+				Path:    Path{"locations", 1, "city"},
+				Key:     CastPatchErrorKey,
+				Args:    map[string]interface{}{"field": "locations[1].city"},
+			},
+		},
+		{
+			name:  "unknown operation",
+			patch: `[{"op": "rename", "path": "/name"}]`,
+			err: Error{
+				Message: "name can't be patched",
+				Field:   "name",
+				Key:     CastPatchErrorKey,
+				Args:    map[string]interface{}{"field": "name"},
+			},
+		},
+		{
+			name:  "malformed",
+			patch: `{"op": "replace"}`,
+			err: Error{
+				Message: "patch is malformed",
+				Key:     CastPatchMalformedKey,
+				Args:    map[string]interface{}{"field": ""},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ch := CastJSONPatch(place, []byte(tt.patch), []string{"name"})
+			assert.Equal(t, []error{tt.err}, ch.Errors())
+			assert.Equal(t, 0, len(ch.Changes()))
+		})
+	}
+}
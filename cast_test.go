@@ -1,9 +1,14 @@
 package changeset
 
 import (
+	"database/sql"
 	"fmt"
+	"math/big"
+	"net"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-rel/changeset/params"
 	"github.com/stretchr/testify/assert"
@@ -780,6 +785,267 @@ func TestCast_ptrWithNilValue(t *testing.T) {
 	assert.Equal(t, expectedTypes, ch.types)
 }
 
+func TestCast_null(t *testing.T) {
+	var (
+		nickname = "nick"
+		data     = struct {
+			Nickname *string
+			Name     string
+			Tags     []string
+			Note     sql.NullString
+		}{
+			Nickname: &nickname,
+			Name:     "name",
+			Tags:     []string{"a"},
+			Note:     sql.NullString{String: "note", Valid: true},
+		}
+		input = params.ParseJSON(`{"nickname": null, "tags": null, "note": null}`)
+	)
+
+	ch := Cast(data, input, []string{"nickname", "name", "tags", "note"})
+	assert.Nil(t, ch.Errors())
+	assert.Equal(t, map[string]interface{}{"nickname": nil, "tags": nil, "note": nil}, ch.Changes())
+	assert.True(t, ch.Changed("nickname"))
+	assert.False(t, ch.Changed("name"))
+}
+
+func TestCast_nullOverridesPreviousChange(t *testing.T) {
+	data := struct {
+		Nickname *string
+	}{}
+
+	ch := Cast(data, params.Map{"nickname": "nick"}, []string{"nickname"})
+	assert.Equal(t, map[string]interface{}{"nickname": "nick"}, ch.Changes())
+
+	ch = Cast(ch, params.Map{"nickname": nil}, []string{"nickname"})
+	assert.Nil(t, ch.Errors())
+	assert.Equal(t, map[string]interface{}{"nickname": nil}, ch.Changes())
+}
+
+func TestCast_nullNotNullable(t *testing.T) {
+	data := struct {
+		Name string
+	}{
+		Name: "name",
+	}
+
+	ch := Cast(data, params.ParseJSON(`{"name": null}`), []string{"name"}, NotNull("name"))
+	assert.Equal(t, 1, len(ch.Errors()))
+	assert.Equal(t, "name can't be null", ch.Error().Error())
+	assert.Equal(t, CastNullErrorKey, ch.Error().(Error).Key)
+	assert.Equal(t, map[string]interface{}{}, ch.Changes())
+}
+
+func TestCast_nullNonPointer(t *testing.T) {
+	var (
+		data = struct {
+			Name string
+		}{
+			Name: "name",
+		}
+		schema = CustomSchema{UUID: "uuid"}
+	)
+
+	ch := Cast(data, params.ParseJSON(`{"name": null}`), []string{"name"})
+	assert.Nil(t, ch.Errors())
+	assert.Equal(t, map[string]interface{}{"name": nil}, ch.Changes())
+
+	// struct that lists its own types is casted the same way.
+	ch = Cast(schema, params.ParseJSON(`{"_uuid": null}`), []string{"_uuid"})
+	assert.Nil(t, ch.Errors())
+	assert.Equal(t, map[string]interface{}{"_uuid": nil}, ch.Changes())
+}
+
+// paramsWithoutPresence is params that can't distinguish absent param from explicit null.
+type paramsWithoutPresence struct {
+	params.Params
+}
+
+func TestCast_nullWithoutPresencer(t *testing.T) {
+	data := struct {
+		Nickname *string
+	}{}
+
+	ch := Cast(data, paramsWithoutPresence{params.Map{"nickname": nil}}, []string{"nickname"}, NotNull("nickname"))
+	assert.Nil(t, ch.Errors())
+	assert.Equal(t, map[string]interface{}{}, ch.Changes())
+}
+
+func TestCast_nullWithNotNull(t *testing.T) {
+	data := struct {
+		Nickname *string
+		Bio      *string
+	}{}
+
+	ch := Cast(data, params.Map{"nickname": nil, "bio": nil}, []string{"nickname", "bio"}, NotNull("nickname"))
+	assert.Equal(t, 1, len(ch.Errors()))
+	assert.Equal(t, "nickname", ch.Error().(Error).Field)
+	assert.Equal(t, "nickname can't be null", ch.Error().Error())
+}
+
+func TestCast_nullAbsent(t *testing.T) {
+	nickname := "nick"
+	data := struct {
+		Nickname *string
+	}{
+		Nickname: &nickname,
+	}
+
+	ch := Cast(data, params.ParseJSON(`{}`), []string{"nickname"}, NotNull("nickname"))
+	assert.Nil(t, ch.Errors())
+	assert.Equal(t, map[string]interface{}{}, ch.Changes())
+}
+
+func TestCast_textUnmarshaler(t *testing.T) {
+	data := struct {
+		IP      net.IP
+		Address net.IP
+	}{}
+
+	ch := Cast(data, params.ParseJSON(`{"ip": "127.0.0.1", "address": "invalid"}`), []string{"ip", "address"})
+	assert.Equal(t, map[string]interface{}{"ip": net.ParseIP("127.0.0.1")}, ch.Changes())
+	assert.Equal(t, 1, len(ch.Errors()))
+	assert.Equal(t, "address is invalid", ch.Error().Error())
+}
+
+func TestCast_converter(t *testing.T) {
+	var (
+		data = struct {
+			Name  string
+			Score int
+		}{}
+		input = params.Map{"name": "luffy", "score": "high"}
+	)
+
+	ch := Cast(data, input, []string{"name", "score"}, Converter(reflect.TypeOf(""), func(raw interface{}) (interface{}, bool) {
+		return strings.ToUpper(raw.(string)), true
+	}), Converter(reflect.TypeOf(0), func(raw interface{}) (interface{}, bool) {
+		return map[string]interface{}{"low": 1, "high": 10}[raw.(string)], true
+	}))
+
+	assert.Nil(t, ch.Errors())
+	assert.Equal(t, map[string]interface{}{"name": "LUFFY", "score": 10}, ch.Changes())
+}
+
+func TestCast_converterInvalidResult(t *testing.T) {
+	data := struct {
+		Score int
+	}{}
+
+	ch := Cast(data, params.Map{"score": "high"}, []string{"score"}, Converter(reflect.TypeOf(0), func(raw interface{}) (interface{}, bool) {
+		return raw, true
+	}))
+
+	assert.Equal(t, "score is invalid", ch.Error().Error())
+	assert.Equal(t, map[string]interface{}{}, ch.Changes())
+}
+
+func TestCast_timeFormat(t *testing.T) {
+	var (
+		data = struct {
+			Birthday  time.Time
+			CreatedAt time.Time
+		}{}
+		input = params.ParseJSON(`{"birthday": "2000-01-02", "created_at": 946771200}`)
+	)
+
+	ch := Cast(data, input, []string{"birthday", "created_at"}, TimeFormat(params.TimeFormat{
+		Layouts: []string{"2006-01-02"},
+		Epoch:   params.EpochSeconds,
+	}))
+
+	assert.Nil(t, ch.Errors())
+	assert.Equal(t, map[string]interface{}{
+		"birthday":   time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC),
+		"created_at": time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC),
+	}, ch.Changes())
+
+	ch = Cast(data, input, []string{"birthday", "created_at"})
+	assert.Equal(t, 2, len(ch.Errors()))
+}
+
+func TestCast_numberOverflow(t *testing.T) {
+	data := struct {
+		Level int8
+		Count uint
+	}{}
+
+	ch := Cast(data, params.ParseJSON(`{"level": 300, "count": -1}`), []string{"level", "count"})
+	assert.Equal(t, 2, len(ch.Errors()))
+	assert.Equal(t, "level is invalid", ch.Errors()[0].Error())
+	assert.Equal(t, "count is invalid", ch.Errors()[1].Error())
+}
+
+func TestCast_bigNumber(t *testing.T) {
+	var (
+		balance = big.NewInt(10)
+		data    = struct {
+			Name    string
+			Balance *big.Int
+			Timeout time.Duration
+		}{
+			Name:    "name",
+			Balance: balance,
+		}
+		input = params.ParseJSON(`{"balance": 123456789012345678901234567890, "timeout": "1m30s"}`)
+	)
+
+	ch := Cast(data, input, []string{"balance", "timeout"})
+	assert.Nil(t, ch.Errors())
+
+	expected, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	assert.Equal(t, *expected, ch.Get("balance"))
+	assert.Equal(t, 90*time.Second, ch.Get("timeout"))
+
+	ch = Cast(data, params.Map{"balance": 10}, []string{"balance"})
+	assert.Nil(t, ch.Errors())
+	assert.Equal(t, map[string]interface{}{}, ch.Changes())
+
+	ch = Cast(data, params.Map{"balance": 11}, []string{"balance"})
+	assert.Nil(t, ch.Errors())
+	assert.Equal(t, *big.NewInt(11), ch.Get("balance"))
+}
+
+func TestCast_strict(t *testing.T) {
+	var (
+		data = struct {
+			Name  string
+			Email string
+			Role  string
+		}{}
+		input = params.ParseJSON(`{"name": "luffy", "emial": "luffy@example.com", "role": "admin"}`)
+	)
+
+	ch := Cast(data, input, []string{"name", "email"}, Strict())
+	assert.Equal(t, []error{
+		Error{Message: "emial is not permitted", Field: "emial", Key: CastStrictErrorKey, Args: map[string]interface{}{"field": "emial"}},
+		Error{Message: "role is not permitted", Field: "role", Key: CastStrictErrorKey, Args: map[string]interface{}{"field": "role"}},
+	}, ch.Errors())
+	assert.Equal(t, map[string]interface{}{"name": "luffy"}, ch.Changes())
+
+	ch = Cast(data, input, []string{"name", "email"})
+	assert.Nil(t, ch.Errors())
+}
+
+func TestCast_strictExistingChangeset(t *testing.T) {
+	var (
+		data = struct {
+			Name string
+			Age  int
+			Role string
+		}{}
+		input = params.Map{"name": "luffy", "age": 19, "role": "admin"}
+	)
+
+	ch := Cast(data, input, []string{"name"}, Strict())
+	ch = Cast(ch, input, []string{"age"}, Strict())
+
+	assert.Equal(t, []error{
+		Error{Message: "role is not permitted", Field: "role", Key: CastStrictErrorKey, Args: map[string]interface{}{"field": "role"}},
+	}, ch.Errors())
+	assert.Equal(t, map[string]interface{}{"name": "luffy", "age": 19}, ch.Changes())
+}
+
 var sliceParams = params.Map{
 	"field1":  []bool{true},
 	"field2":  []int{2},
@@ -18,8 +18,19 @@ type Changeset struct {
 	values        map[string]interface{}
 	types         map[string]reflect.Type
 	constraints   Constraints
+	onReplace     map[string]ReplacePolicy
+	embeds        map[string]bool
+	permitted     map[string]bool
 	zero          bool
 	ignorePrimary bool
+	deleted       bool
+	strict        bool
+}
+
+// Diff of a changed field.
+type Diff struct {
+	Old interface{}
+	New interface{}
 }
 
 // Errors of changeset.
@@ -50,6 +61,80 @@ func (c Changeset) Fetch(field string) interface{} {
 	return c.values[field]
 }
 
+// Changed returns true if the field has a change that differs from its original value.
+func (c Changeset) Changed(field string) bool {
+	_, _, changed := c.GetChange(field)
+	return changed
+}
+
+// GetChange returns the original value and the new value of a changed field.
+// The last return value will be false if the field is not changed.
+// For association, the new value is the association's *Changeset or []*Changeset.
+func (c Changeset) GetChange(field string) (interface{}, interface{}, bool) {
+	change, exist := c.changes[field]
+	if !exist {
+		return nil, nil, false
+	}
+
+	var (
+		old     = c.values[field]
+		changed bool
+	)
+
+	switch v := change.(type) {
+	case *Changeset:
+		changed = len(v.Diff()) > 0
+	case []*Changeset:
+		count := 0
+		if rv := reflect.ValueOf(old); rv.IsValid() {
+			count = rv.Len()
+		}
+
+		changed = count != len(v)
+		for i := range v {
+			changed = changed || len(v[i].Diff()) > 0
+		}
+	default:
+		changed = !reflect.DeepEqual(old, change)
+	}
+
+	if !changed {
+		return nil, nil, false
+	}
+
+	return old, change, true
+}
+
+// Diff returns the original and new value of every changed field.
+// The new value of association is nested diff, map[string]Diff for single association
+// and []map[string]Diff for many association.
+func (c Changeset) Diff() map[string]Diff {
+	diff := make(map[string]Diff)
+
+	for field := range c.changes {
+		old, change, changed := c.GetChange(field)
+		if !changed {
+			continue
+		}
+
+		switch v := change.(type) {
+		case *Changeset:
+			diff[field] = Diff{Old: old, New: v.Diff()}
+		case []*Changeset:
+			diffs := make([]map[string]Diff, len(v))
+			for i := range v {
+				diffs[i] = v[i].Diff()
+			}
+
+			diff[field] = Diff{Old: old, New: diffs}
+		default:
+			diff[field] = Diff{Old: old, New: change}
+		}
+	}
+
+	return diff
+}
+
 // Changes of changeset.
 func (c Changeset) Changes() map[string]interface{} {
 	return c.changes
@@ -80,15 +165,19 @@ func (c *Changeset) Apply(doc *rel.Document, mut *rel.Mutation) {
 	for field, value := range c.changes {
 		switch v := value.(type) {
 		case *Changeset:
-			if mut.Cascade {
+			if c.embeds[field] {
+				c.applyEmbedOne(doc, field, mut, v)
+			} else if mut.Cascade {
 				c.applyAssocOne(doc, field, mut, v)
 			}
 		case []*Changeset:
-			if mut.Cascade {
+			if c.embeds[field] {
+				c.applyEmbedMany(doc, field, mut, v)
+			} else if mut.Cascade {
 				c.applyAssocMany(doc, field, mut, v)
 			}
 		default:
-			if (pField != field || pField == field && !c.ignorePrimary) && scannable(c.types[field]) {
+			if (pField != field || pField == field && !c.ignorePrimary) && (scannable(c.types[field]) || c.embeds[field]) {
 				c.set(doc, mut, field, v)
 			}
 		}
@@ -118,31 +207,170 @@ func (c *Changeset) set(doc *rel.Document, mut *rel.Mutation, field string, valu
 	}
 }
 
+func (c *Changeset) applyEmbedOne(doc *rel.Document, field string, mut *rel.Mutation, ch *Changeset) {
+	base, _ := doc.Value(field)
+	c.set(doc, mut, field, ch.embed(c.types[field], base).Elem().Interface())
+}
+
+func (c *Changeset) applyEmbedMany(doc *rel.Document, field string, mut *rel.Mutation, chs []*Changeset) {
+	var (
+		value, _ = doc.Value(field)
+		rt       = reflect.TypeOf(value)
+		elemType = c.types[field].Elem()
+		rv       = reflect.MakeSlice(rt, len(chs), len(chs))
+	)
+
+	for i := range chs {
+		elem := chs[i].embed(elemType, nil)
+		if rt.Elem().Kind() == reflect.Ptr {
+			rv.Index(i).Set(elem)
+		} else {
+			rv.Index(i).Set(elem.Elem())
+		}
+	}
+
+	c.set(doc, mut, field, rv.Interface())
+}
+
+// embed materializes changes of embedded changeset on top of base value, returns pointer to the new value.
+func (c *Changeset) embed(rt reflect.Type, base interface{}) reflect.Value {
+	rv := reflect.New(rt)
+	if base != nil {
+		rv.Elem().Set(reflect.ValueOf(base))
+	}
+
+	doc := rel.NewDocument(rv.Interface())
+	for field, value := range c.changes {
+		switch v := value.(type) {
+		case *Changeset:
+			if c.embeds[field] {
+				c.applyEmbedOne(doc, field, &rel.Mutation{}, v)
+			}
+		case []*Changeset:
+			if c.embeds[field] {
+				c.applyEmbedMany(doc, field, &rel.Mutation{}, v)
+			}
+		default:
+			doc.SetValue(field, v)
+		}
+	}
+
+	return rv
+}
+
 func (c *Changeset) applyAssocOne(doc *rel.Document, field string, mut *rel.Mutation, ch *Changeset) {
 	var (
 		assoc       = doc.Association(field)
 		assocDoc, _ = assoc.Document()
-		assocMut    = rel.Apply(assocDoc, ch)
 	)
 
-	mut.SetAssoc(field, assocMut)
+	mut.SetAssoc(field, rel.Apply(assocDoc, ch))
 }
 
 func (c *Changeset) applyAssocMany(doc *rel.Document, field string, mut *rel.Mutation, chs []*Changeset) {
 	var (
-		assoc  = doc.Association(field)
-		col, _ = assoc.Collection()
-		muts   = make([]rel.Mutation, len(chs))
+		assoc   = doc.Association(field)
+		col, _  = assoc.Collection()
+		pField  = ""
+		deleted = false
 	)
 
-	// Reset assoc.
-	col.Reset()
-
 	for i := range chs {
-		muts[i] = rel.Apply(col.Add(), chs[i])
+		deleted = deleted || chs[i].deleted
+	}
+
+	if col.Len() > 0 || deleted {
+		pField = inferPrimaryField(col.NewDocument().ReflectValue().Type())
+	}
+
+	if pField == "" {
+		// Reset assoc.
+		col.Reset()
+
+		muts := make([]rel.Mutation, 0, len(chs))
+		for i := range chs {
+			if !chs[i].deleted {
+				muts = append(muts, rel.Apply(col.Add(), chs[i]))
+			}
+		}
+
+		mut.SetAssoc(field, muts...)
+		return
+	}
+
+	var (
+		pIndex     = make(map[interface{}]int, col.Len())
+		muts       = make([]rel.Mutation, 0, len(chs))
+		deletes    = make(map[interface{}]bool)
+		deletedIDs = []interface{}{}
+		inserts    []*Changeset
+		curr       = 0
+	)
+
+	for i := 0; i < col.Len(); i++ {
+		pIndex[col.Get(i).PrimaryValue()] = i
+	}
+
+	// update matched association in place, and move it to the front of collection.
+	for _, ch := range chs {
+		pValue := ch.values[pField]
+
+		index, matched := pIndex[pValue]
+		switch {
+		case ch.deleted:
+			if !matched && pValue != nil && !isZero(pValue) {
+				// association is not loaded, delete it by its primary value.
+				deletedIDs = append(deletedIDs, pValue)
+			}
+
+			deletes[pValue] = true
+			continue
+		case !matched:
+			inserts = append(inserts, ch)
+			continue
+		}
+
+		delete(pIndex, pValue)
+
+		if index != curr {
+			col.Swap(index, curr)
+			pIndex[col.Get(index).PrimaryValue()] = index
+		}
+
+		muts = append(muts, rel.Apply(col.Get(curr), ch))
+		curr++
+	}
+
+	// handle replaced association that remains in the collection, explicitly deleted association is always deleted.
+	var (
+		policy = c.onReplace[field]
+		kept   = curr
+	)
+
+	for i := curr; i < col.Len(); i++ {
+		pValue := col.Get(i).PrimaryValue()
+
+		switch {
+		case deletes[pValue] || policy == ReplaceDelete:
+			deletedIDs = append(deletedIDs, pValue)
+		default:
+			if i != kept {
+				col.Swap(i, kept)
+			}
+
+			muts = append(muts, rel.Mutation{})
+			kept++
+		}
+	}
+
+	col.Truncate(0, kept)
+
+	for _, ch := range inserts {
+		muts = append(muts, rel.Apply(col.Add(), ch))
 	}
 
 	mut.SetAssoc(field, muts...)
+	mut.SetDeletedIDs(field, deletedIDs)
 }
 
 var (
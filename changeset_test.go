@@ -325,3 +325,252 @@ func TestChangesetApply_updatePK_fromChange(t *testing.T) {
 		UpdatedAt: now,
 	}, user)
 }
+
+func TestChangeset_GetChange(t *testing.T) {
+	var (
+		user  = User{Name: "Luffy", Age: 20}
+		input = params.Map{
+			"name": "Zoro",
+			"age":  20,
+		}
+		ch = Cast(user, input, []string{"name", "age"})
+	)
+
+	PutChange(ch, "age", 20)
+
+	old, new, changed := ch.GetChange("name")
+	assert.True(t, changed)
+	assert.Equal(t, "Luffy", old)
+	assert.Equal(t, "Zoro", new)
+	assert.True(t, ch.Changed("name"))
+
+	old, new, changed = ch.GetChange("age")
+	assert.False(t, changed)
+	assert.Nil(t, old)
+	assert.Nil(t, new)
+	assert.False(t, ch.Changed("age"))
+
+	assert.False(t, ch.Changed("id"))
+}
+
+func TestChangeset_Diff(t *testing.T) {
+	var (
+		user = User{
+			Name:         "Luffy",
+			Transactions: []Transaction{{ID: 1, Item: "Sword"}},
+			Address:      Address{Street: "Grove Street"},
+		}
+		input = params.Map{
+			"name": "Zoro",
+			"age":  0,
+			"transactions": []params.Map{
+				{"item": "Shield"},
+			},
+			"address": params.Map{
+				"street": "Grove Street",
+			},
+		}
+		changeFn = func(fields ...string) ChangeFunc {
+			return func(data interface{}, input params.Params) *Changeset {
+				return Cast(data, input, fields)
+			}
+		}
+	)
+
+	ch := Cast(user, input, []string{"name", "age"})
+	CastAssoc(ch, "transactions", changeFn("item"))
+	CastAssoc(ch, "address", changeFn("street"))
+
+	assert.Equal(t, map[string]Diff{
+		"name": {Old: "Luffy", New: "Zoro"},
+		"transactions": {
+			Old: user.Transactions,
+			New: []map[string]Diff{
+				{"item": {Old: "", New: "Shield"}},
+			},
+		},
+	}, ch.Diff())
+}
+
+func TestChangesetApply_updateHasMany(t *testing.T) {
+	changeTransaction := func(data interface{}, input params.Params) *Changeset {
+		return Cast(data, input, []string{"item"})
+	}
+
+	tests := []struct {
+		name         string
+		policy       ReplacePolicy
+		deletedIDs   []interface{}
+		mutations    int
+		transactions []Transaction
+	}{
+		{
+			name:       "delete",
+			policy:     ReplaceDelete,
+			deletedIDs: []interface{}{1, 3},
+			mutations:  2,
+			transactions: []Transaction{
+				{ID: 2, Item: "Axe", BuyerID: 10},
+				{Item: "Bow"},
+			},
+		},
+		{
+			name:       "keep",
+			policy:     ReplaceKeep,
+			deletedIDs: []interface{}{},
+			mutations:  4,
+			transactions: []Transaction{
+				{ID: 2, Item: "Axe", BuyerID: 10},
+				{ID: 1, Item: "Sword", BuyerID: 10},
+				{ID: 3, Item: "Shield", BuyerID: 10},
+				{Item: "Bow"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				user = User{
+					ID: 10,
+					Transactions: []Transaction{
+						{ID: 1, Item: "Sword", BuyerID: 10},
+						{ID: 2, Item: "Spear", BuyerID: 10},
+						{ID: 3, Item: "Shield", BuyerID: 10},
+					},
+				}
+				input = params.Map{
+					"transactions": []params.Map{
+						{"id": 2, "item": "Axe"},
+						{"item": "Bow"},
+					},
+				}
+			)
+
+			ch := Cast(user, input, []string{})
+			CastAssoc(ch, "transactions", changeTransaction, OnReplace(tt.policy))
+			assert.Nil(t, ch.Error())
+
+			var (
+				mut   = rel.Apply(rel.NewDocument(&user), ch)
+				assoc = mut.Assoc["transactions"]
+			)
+
+			assert.Equal(t, tt.deletedIDs, assoc.DeletedIDs)
+			assert.Equal(t, tt.mutations, len(assoc.Mutations))
+			assert.Equal(t, rel.Set("item", "Axe"), assoc.Mutations[0].Mutates["item"])
+			assert.Equal(t, rel.Set("item", "Bow"), assoc.Mutations[tt.mutations-1].Mutates["item"])
+			assert.Equal(t, tt.transactions, user.Transactions)
+		})
+	}
+}
+
+func TestChangesetApply_deleteAssoc(t *testing.T) {
+	var (
+		user = User{
+			ID: 10,
+			Transactions: []Transaction{
+				{ID: 1, Item: "Sword", BuyerID: 10},
+				{ID: 2, Item: "Spear", BuyerID: 10},
+			},
+		}
+		input = params.Map{
+			"transactions": []params.Map{
+				{"id": 2, "_delete": true},
+				{"id": 3, "_delete": true},
+				{"item": "Bow"},
+			},
+		}
+		changeFn = func(data interface{}, input params.Params) *Changeset {
+			return Cast(data, input, []string{"item", "street"})
+		}
+	)
+
+	ch := Cast(user, input, []string{})
+	CastAssoc(ch, "transactions", changeFn, DeleteField("_delete"), OnReplace(ReplaceKeep))
+	assert.Nil(t, ch.Error())
+
+	mut := rel.Apply(rel.NewDocument(&user), ch)
+	assert.Equal(t, []interface{}{3, 2}, mut.Assoc["transactions"].DeletedIDs)
+	assert.Equal(t, 2, len(mut.Assoc["transactions"].Mutations))
+	assert.Equal(t, []Transaction{
+		{ID: 1, Item: "Sword", BuyerID: 10},
+		{Item: "Bow"},
+	}, user.Transactions)
+}
+
+func TestChangesetApply_deleteAssocOne(t *testing.T) {
+	var (
+		user  = User{ID: 10, Address: Address{ID: 5, Street: "Grove Street"}}
+		input = params.Map{
+			"address": params.Map{"_delete": true},
+		}
+	)
+
+	ch := Cast(user, input, []string{})
+	CastAssoc(ch, "address", func(data interface{}, input params.Params) *Changeset {
+		return Cast(data, input, []string{"street"})
+	}, DeleteField("_delete"))
+	assert.Equal(t, []error{Error{
+		Message: "address cannot be deleted",
+		Field:   "address",
+		Key:     CastAssocDeleteErrorKey,
+		Args:    map[string]interface{}{"field": "address"},
+	}}, ch.Errors())
+
+	mut := rel.Apply(rel.NewDocument(&user), ch)
+	_, changed := mut.Assoc["address"]
+	assert.False(t, changed)
+	assert.Equal(t, Address{ID: 5, Street: "Grove Street"}, user.Address)
+}
+
+func TestChangesetApply_deleteAssocWithoutLoadedChild(t *testing.T) {
+	var (
+		user  = User{ID: 1}
+		input = params.Map{
+			"transactions": []params.Map{
+				{"item": "Shield", "_delete": true},
+			},
+			"address": params.Map{
+				"_delete": true,
+			},
+		}
+		changeFn = func(data interface{}, input params.Params) *Changeset {
+			return Cast(data, input, []string{"item", "street"})
+		}
+	)
+
+	ch := Cast(user, input, []string{})
+	CastAssoc(ch, "transactions", changeFn, DeleteField("_delete"), PrimaryKey("item"))
+	CastAssoc(ch, "address", changeFn, DeleteField("_delete"))
+	assert.Equal(t, []error{
+		Error{Message: "transactions is invalid", Field: "transactions", Key: CastAssocErrorKey, Args: map[string]interface{}{"field": "transactions"}},
+		Error{Message: "address cannot be deleted", Field: "address", Key: CastAssocDeleteErrorKey, Args: map[string]interface{}{"field": "address"}},
+	}, ch.Errors())
+
+	mut := rel.Apply(rel.NewDocument(&user), ch)
+	assert.Nil(t, mut.Assoc["transactions"].DeletedIDs)
+	assert.Nil(t, mut.Assoc["address"].DeletedIDs)
+}
+
+func TestChangesetApply_deleteAssocNotLoaded(t *testing.T) {
+	var (
+		user  = User{ID: 10}
+		input = params.Map{
+			"transactions": []params.Map{
+				{"id": 2, "_delete": true},
+			},
+		}
+	)
+
+	ch := Cast(user, input, []string{})
+	CastAssoc(ch, "transactions", func(data interface{}, input params.Params) *Changeset {
+		return Cast(data, input, []string{"item"})
+	}, DeleteField("_delete"))
+	assert.Nil(t, ch.Error())
+
+	mut := rel.Apply(rel.NewDocument(&user), ch)
+	assert.Equal(t, []interface{}{2}, mut.Assoc["transactions"].DeletedIDs)
+	assert.Equal(t, 0, len(mut.Assoc["transactions"].Mutations))
+	assert.Equal(t, 0, len(user.Transactions))
+}
@@ -9,6 +9,9 @@ import (
 // CheckConstraintMessage is the default error message for CheckConstraint.
 var CheckConstraintMessage = "{field} is invalid"
 
+// CheckConstraintKey is the message key of CheckConstraint error.
+const CheckConstraintKey = "check_constraint"
+
 // CheckConstraint adds an unique constraint to changeset.
 func CheckConstraint(ch *Changeset, field string, opts ...Option) {
 	options := Options{
@@ -21,9 +24,11 @@ func CheckConstraint(ch *Changeset, field string, opts ...Option) {
 	ch.constraints = append(ch.constraints, Constraint{
 		Field:   field,
 		Message: strings.Replace(options.message, "{field}", field, 1),
+		Key:     CheckConstraintKey,
 		Code:    options.code,
 		Name:    options.name,
 		Exact:   options.exact,
+		custom:  options.customMessage,
 		Type:    rel.CheckConstraint,
 	})
 }
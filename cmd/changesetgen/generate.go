@@ -0,0 +1,359 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/azer/snakecase"
+)
+
+const directive = "changeset:generate"
+
+type field struct {
+	Name    string
+	Expr    string
+	Type    string
+	Pointer bool
+}
+
+type schema struct {
+	Name     string
+	Receiver string
+	Fields   []field
+}
+
+type file struct {
+	Package string
+	Imports []string
+	Schemas []schema
+}
+
+var tmpl = template.Must(template.New("changeset").Parse(`// Code generated by changesetgen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{range .Imports}}{{if .}}	{{.}}
+{{else}}
+{{end}}{{end}})
+{{range .Schemas}}{{$schema := .}}
+var (
+	changeset{{.Name}}Fields = map[string]int{
+{{range $i, $f := .Fields}}		{{printf "%q" $f.Name}}: {{$i}},
+{{end}}	}
+	changeset{{.Name}}Types = []reflect.Type{
+{{range .Fields}}		reflect.TypeOf((*{{.Type}})(nil)).Elem(),
+{{end}}	}
+)
+
+// Fields returns field names of {{.Name}} mapped to its index in Types and Values.
+func ({{.Receiver}} {{.Name}}) Fields() map[string]int {
+	return changeset{{.Name}}Fields
+}
+
+// Types returns field types of {{.Name}}.
+func ({{.Receiver}} {{.Name}}) Types() []reflect.Type {
+	return changeset{{.Name}}Types
+}
+
+// Values returns field values of {{.Name}}.
+func ({{.Receiver}} {{.Name}}) Values() []interface{} {
+	values := []interface{}{
+{{range .Fields}}		{{if .Pointer}}nil{{else}}{{$schema.Receiver}}.{{.Expr}}{{end}},
+{{end}}	}
+{{range $i, $f := .Fields}}{{if $f.Pointer}}
+	if {{$schema.Receiver}}.{{$f.Expr}} != nil {
+		values[{{$i}}] = *{{$schema.Receiver}}.{{$f.Expr}}
+	}
+{{end}}{{end}}
+	return values
+}
+{{end}}`))
+
+// generate returns source code of methods for annotated structs in the given file.
+// nil is returned when the file doesn't contain any annotated struct.
+func generate(filename string, src []byte) ([]byte, error) {
+	var (
+		fset      = token.NewFileSet()
+		node, err = parser.ParseFile(fset, filename, src, parser.ParseComments)
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result   = file{Package: node.Name.Name}
+		packages = make(map[string]bool)
+	)
+
+	for _, decl := range node.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok || !(annotated(gen.Doc) || annotated(ts.Doc)) {
+				continue
+			}
+
+			if ts.TypeParams != nil {
+				return nil, fmt.Errorf("%s: generic struct is not supported", ts.Name.Name)
+			}
+
+			s, err := inspectStruct(fset, ts.Name.Name, st, packages)
+			if err != nil {
+				return nil, err
+			}
+
+			result.Schemas = append(result.Schemas, s)
+		}
+	}
+
+	if len(result.Schemas) == 0 {
+		return nil, nil
+	}
+
+	if result.Imports, err = resolveImports(node, packages); err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, result); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buffer.Bytes())
+}
+
+func annotated(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+
+	for _, comment := range doc.List {
+		if strings.TrimSpace(strings.TrimPrefix(comment.Text, "//")) == directive {
+			return true
+		}
+	}
+
+	return false
+}
+
+func inspectStruct(fset *token.FileSet, name string, st *ast.StructType, packages map[string]bool) (schema, error) {
+	s := schema{
+		Name:     name,
+		Receiver: receiverName(name),
+	}
+
+	for _, f := range st.Fields.List {
+		var (
+			tag   reflect.StructTag
+			names []string
+		)
+
+		if f.Tag != nil {
+			value, err := strconv.Unquote(f.Tag.Value)
+			if err != nil {
+				return s, err
+			}
+
+			tag = reflect.StructTag(value)
+		}
+
+		if len(f.Names) == 0 {
+			names = []string{embeddedName(f.Type)}
+		} else {
+			for _, ident := range f.Names {
+				names = append(names, ident.Name)
+			}
+		}
+
+		for _, fieldName := range names {
+			name := inferFieldName(fieldName, tag)
+			if name == "" {
+				continue
+			}
+
+			typ, pointer := schemaType(f.Type)
+
+			var buffer bytes.Buffer
+			if err := printer.Fprint(&buffer, fset, typ); err != nil {
+				return s, err
+			}
+
+			collectPackages(typ, packages)
+
+			s.Fields = append(s.Fields, field{
+				Name:    name,
+				Expr:    fieldName,
+				Type:    buffer.String(),
+				Pointer: pointer,
+			})
+		}
+	}
+
+	return s, nil
+}
+
+// inferFieldName follows the same rule as changeset's reflection based field name inference.
+func inferFieldName(name string, tag reflect.StructTag) string {
+	for _, key := range []string{"ch", "db"} {
+		if v := tag.Get(key); v != "" {
+			if n := strings.Split(v, ",")[0]; n == "-" {
+				return ""
+			} else if n != "" {
+				return n
+			}
+		}
+	}
+
+	return snakecase.SnakeCase(name)
+}
+
+// schemaType dereferences pointer and slice of pointer the same way as changeset's type inference.
+// the second return value is true if field is a pointer.
+func schemaType(expr ast.Expr) (ast.Expr, bool) {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return t.X, true
+	case *ast.ArrayType:
+		if star, ok := t.Elt.(*ast.StarExpr); ok && t.Len == nil {
+			return &ast.ArrayType{Elt: star.X}, false
+		}
+	}
+
+	return expr, false
+}
+
+func embeddedName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return embeddedName(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	case *ast.Ident:
+		return t.Name
+	}
+
+	return ""
+}
+
+func collectPackages(expr ast.Expr, packages map[string]bool) {
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				packages[ident.Name] = true
+			}
+
+			return false
+		}
+
+		return true
+	})
+}
+
+var majorVersion = regexp.MustCompile(`^v[0-9]+$`)
+
+// resolveImports finds import spec of each package used by field types.
+// Package name of import without alias is guessed from its path.
+func resolveImports(node *ast.File, packages map[string]bool) ([]string, error) {
+	var (
+		std      = []string{`"reflect"`}
+		others   []string
+		resolved = make(map[string]bool)
+	)
+
+	for _, spec := range node.Imports {
+		var (
+			importPath, _ = strconv.Unquote(spec.Path.Value)
+			name          = guessPackageName(importPath)
+		)
+
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+
+		if packages[name] && !resolved[name] && importPath != "reflect" {
+			imp := spec.Path.Value
+			if spec.Name != nil {
+				imp = name + " " + imp
+			}
+
+			if strings.Contains(strings.Split(importPath, "/")[0], ".") {
+				others = append(others, imp)
+			} else {
+				std = append(std, imp)
+			}
+		}
+
+		resolved[name] = true
+	}
+
+	for name := range packages {
+		if !resolved[name] {
+			return nil, fmt.Errorf("cannot resolve import of package %s, consider adding an import alias", name)
+		}
+	}
+
+	sort.Strings(std)
+	sort.Strings(others)
+
+	if len(others) > 0 {
+		// empty string is rendered as blank line separating standard library imports.
+		return append(append(std, ""), others...), nil
+	}
+
+	return std, nil
+}
+
+func guessPackageName(importPath string) string {
+	var (
+		base = path.Base(importPath)
+		dir  = path.Dir(importPath)
+	)
+
+	if majorVersion.MatchString(base) && dir != "." {
+		base = path.Base(dir)
+	}
+
+	if i := strings.Index(base, ".v"); i > 0 {
+		base = base[:i]
+	}
+
+	for _, affix := range []string{"go-", "go."} {
+		base = strings.TrimPrefix(base, affix)
+	}
+
+	for _, affix := range []string{"-go", ".go"} {
+		base = strings.TrimSuffix(base, affix)
+	}
+
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == '.' {
+			return '_'
+		}
+
+		return r
+	}, base)
+}
+
+func receiverName(name string) string {
+	return string(unicode.ToLower([]rune(name)[0]))
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-rel/changeset"
+	"github.com/go-rel/changeset/cmd/changesetgen/testdata/equivalence"
+	"github.com/go-rel/changeset/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	src, err := os.ReadFile("testdata/user.go")
+	assert.Nil(t, err)
+
+	out, err := generate("user.go", src)
+	assert.Nil(t, err)
+
+	expected, err := os.ReadFile("testdata/user_changeset.go.golden")
+	assert.Nil(t, err)
+	assert.Equal(t, string(expected), string(out))
+}
+
+// reflectiveProfile has the fields of equivalence.Profile without its generated methods.
+type reflectiveProfile equivalence.Profile
+
+func TestGenerate_equivalence(t *testing.T) {
+	src, err := os.ReadFile("testdata/equivalence/profile.go")
+	assert.Nil(t, err)
+
+	out, err := generate("profile.go", src)
+	assert.Nil(t, err)
+
+	expected, err := os.ReadFile("testdata/equivalence/profile_changeset.go")
+	assert.Nil(t, err)
+	assert.Equal(t, string(expected), string(out), "generated code is outdated")
+
+	var (
+		bio     = "pirate"
+		updated = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		profile = equivalence.Profile{
+			ID:        1,
+			Name:      "Luffy",
+			Bio:       &bio,
+			Age:       19,
+			Tags:      []string{"captain"},
+			UpdatedAt: &updated,
+		}
+		fields = []string{"id", "full_name", "bio", "age", "score", "active", "tags", "secret", "birth_date", "updated_at"}
+		tests  = []params.Params{
+			params.Map{
+				"id":         1,
+				"full_name":  "Monkey D. Luffy",
+				"bio":        "pirate",
+				"age":        "20",
+				"score":      99.5,
+				"active":     true,
+				"tags":       []string{"captain", "rubber"},
+				"secret":     "meat",
+				"birth_date": "2001-05-05T00:00:00Z",
+				"updated_at": "2020-01-01T00:00:00Z",
+			},
+			params.Form{
+				"full_name": {"Zoro"},
+				"age":       {"twenty"},
+				"score":     {"1.5"},
+				"active":    {"true"},
+				"tags":      {"swordsman"},
+			},
+			params.ParseJSON(`{"full_name": null, "bio": null, "age": null, "tags": null, "updated_at": null}`),
+		}
+	)
+
+	for _, p := range tests {
+		var (
+			generated  = changeset.Cast(profile, p, fields)
+			reflective = changeset.Cast(reflectiveProfile(profile), p, fields)
+		)
+
+		assert.Equal(t, reflective.Changes(), generated.Changes())
+		assert.Equal(t, reflective.Errors(), generated.Errors())
+	}
+}
+
+func TestGenerate_notAnnotated(t *testing.T) {
+	out, err := generate("address.go", []byte(`package testdata
+
+type Address struct {
+	Street string
+}
+`))
+
+	assert.Nil(t, err)
+	assert.Nil(t, out)
+}
+
+func TestGenerate_unresolvedImport(t *testing.T) {
+	_, err := generate("address.go", []byte(`package testdata
+
+import "example.com/geo/v2/latlng"
+
+// changeset:generate
+type Address struct {
+	Location coord.Point
+}
+`))
+
+	assert.EqualError(t, err, "cannot resolve import of package coord, consider adding an import alias")
+}
+
+func TestGuessPackageName(t *testing.T) {
+	tests := map[string]string{
+		"time":                        "time",
+		"database/sql":                "sql",
+		"gopkg.in/yaml.v3":            "yaml",
+		"github.com/go-rel/rel":       "rel",
+		"github.com/jackc/pgx/v5":     "pgx",
+		"github.com/mattn/go-sqlite3": "sqlite3",
+		"github.com/golang/protobuf":  "protobuf",
+		"github.com/nats-io/nats.go":  "nats",
+		"github.com/satori/go.uuid":   "uuid",
+	}
+
+	for path, name := range tests {
+		assert.Equal(t, name, guessPackageName(path), path)
+	}
+}
@@ -0,0 +1,63 @@
+// Command changesetgen generates Fields, Types and Values methods for structs annotated with changeset:generate comment.
+// The generated methods let changeset read the schema of a struct without reflection.
+//
+//	//go:generate go run github.com/go-rel/changeset/cmd/changesetgen
+//
+//	// User model.
+//	// changeset:generate
+//	type User struct {
+//		ID   int
+//		Name string `ch:"full_name"`
+//	}
+//
+// When no file is given, the file named by $GOFILE is used.
+// Methods for structs in file.go are written to file_changeset.go.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: changesetgen [file.go ...]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	files := flag.Args()
+	if len(files) == 0 {
+		if gofile := os.Getenv("GOFILE"); gofile != "" {
+			files = []string{gofile}
+		}
+	}
+
+	if len(files) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	for _, file := range files {
+		if err := generateFile(file); err != nil {
+			fmt.Fprintln(os.Stderr, "changesetgen:", err)
+			os.Exit(1)
+		}
+	}
+}
+
+func generateFile(filename string) error {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	out, err := generate(filename, src)
+	if err != nil || out == nil {
+		return err
+	}
+
+	return os.WriteFile(strings.TrimSuffix(filename, ".go")+"_changeset.go", out, 0644)
+}
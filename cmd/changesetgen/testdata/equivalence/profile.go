@@ -0,0 +1,20 @@
+package equivalence
+
+import (
+	"time"
+)
+
+// Profile model.
+// changeset:generate
+type Profile struct {
+	ID        int
+	Name      string `ch:"full_name"`
+	Bio       *string
+	Age       int
+	Score     float64
+	Active    bool
+	Tags      []string
+	Secret    string `db:"-"`
+	BirthDate time.Time
+	UpdatedAt *time.Time
+}
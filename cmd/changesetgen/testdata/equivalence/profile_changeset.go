@@ -0,0 +1,68 @@
+// Code generated by changesetgen. DO NOT EDIT.
+
+package equivalence
+
+import (
+	"reflect"
+	"time"
+)
+
+var (
+	changesetProfileFields = map[string]int{
+		"id":         0,
+		"full_name":  1,
+		"bio":        2,
+		"age":        3,
+		"score":      4,
+		"active":     5,
+		"tags":       6,
+		"birth_date": 7,
+		"updated_at": 8,
+	}
+	changesetProfileTypes = []reflect.Type{
+		reflect.TypeOf((*int)(nil)).Elem(),
+		reflect.TypeOf((*string)(nil)).Elem(),
+		reflect.TypeOf((*string)(nil)).Elem(),
+		reflect.TypeOf((*int)(nil)).Elem(),
+		reflect.TypeOf((*float64)(nil)).Elem(),
+		reflect.TypeOf((*bool)(nil)).Elem(),
+		reflect.TypeOf((*[]string)(nil)).Elem(),
+		reflect.TypeOf((*time.Time)(nil)).Elem(),
+		reflect.TypeOf((*time.Time)(nil)).Elem(),
+	}
+)
+
+// Fields returns field names of Profile mapped to its index in Types and Values.
+func (p Profile) Fields() map[string]int {
+	return changesetProfileFields
+}
+
+// Types returns field types of Profile.
+func (p Profile) Types() []reflect.Type {
+	return changesetProfileTypes
+}
+
+// Values returns field values of Profile.
+func (p Profile) Values() []interface{} {
+	values := []interface{}{
+		p.ID,
+		p.Name,
+		nil,
+		p.Age,
+		p.Score,
+		p.Active,
+		p.Tags,
+		p.BirthDate,
+		nil,
+	}
+
+	if p.Bio != nil {
+		values[2] = *p.Bio
+	}
+
+	if p.UpdatedAt != nil {
+		values[8] = *p.UpdatedAt
+	}
+
+	return values
+}
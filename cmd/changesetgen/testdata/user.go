@@ -0,0 +1,42 @@
+package testdata
+
+import (
+	"database/sql"
+	"time"
+
+	decimal "github.com/shopspring/decimal"
+)
+
+// Base fields.
+type Base struct {
+	ID int
+}
+
+// User model.
+// changeset:generate
+type User struct {
+	Base
+	Name        string `ch:"full_name"`
+	Email       string `db:"email_address"`
+	Password    string `db:"-"`
+	Nickname    string `db:"-" ch:"nickname"`
+	Age, Height int
+	Balance     decimal.Decimal
+	Notes       sql.NullString
+	Tags        []string
+	Items       []*Item
+	Address     *Address
+	CreatedAt   time.Time
+	DeletedAt   *time.Time `db:",primary"`
+}
+
+// changeset:generate
+type Item struct {
+	ID   int
+	Name string
+}
+
+// Address is not annotated.
+type Address struct {
+	Street string
+}
@@ -11,10 +11,12 @@ import (
 type Constraint struct {
 	Field   string
 	Message string
+	Key     string
 	Code    int
 	Name    string
 	Exact   bool
 	Type    rel.ConstraintType
+	custom  bool
 }
 
 // Constraints is slice of Constraint
@@ -44,7 +46,10 @@ func (constraints Constraints) GetError(err error) error {
 				Message: c.Message,
 				Field:   c.Field,
 				Code:    c.Code,
+				Key:     c.Key,
+				Args:    map[string]interface{}{"field": c.Field},
 				Err:     err,
+				custom:  c.custom,
 			}
 		}
 	}
@@ -0,0 +1,123 @@
+package changeset
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Path locates a field inside nested changesets.
+// Each segment is either a field name (string) or an index of has many association (int),
+// for example items[2].name is Path{"items", 2, "name"}.
+type Path []interface{}
+
+// HasPrefix returns true if path starts with given prefix.
+func (p Path) HasPrefix(prefix Path) bool {
+	if len(prefix) > len(p) {
+		return false
+	}
+
+	for i := range prefix {
+		if p[i] != prefix[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns path in dot and bracket notation, eg: items[2].name.
+func (p Path) String() string {
+	var buffer strings.Builder
+
+	for _, segment := range p {
+		switch s := segment.(type) {
+		case int:
+			buffer.WriteString("[" + strconv.Itoa(s) + "]")
+		case string:
+			if buffer.Len() > 0 {
+				buffer.WriteByte('.')
+			}
+
+			buffer.WriteString(s)
+		}
+	}
+
+	return buffer.String()
+}
+
+// ErrorTree contains errors of changeset arranged by path, mirroring the shape of params.
+type ErrorTree struct {
+	Errors []Error               `json:"errors,omitempty"`
+	Fields map[string]*ErrorTree `json:"fields,omitempty"`
+	Items  []*ErrorTree          `json:"items,omitempty"`
+}
+
+func (et *ErrorTree) field(name string) *ErrorTree {
+	if et.Fields == nil {
+		et.Fields = make(map[string]*ErrorTree)
+	}
+
+	if et.Fields[name] == nil {
+		et.Fields[name] = &ErrorTree{}
+	}
+
+	return et.Fields[name]
+}
+
+func (et *ErrorTree) item(index int) *ErrorTree {
+	if index >= len(et.Items) {
+		et.Items = append(et.Items, make([]*ErrorTree, index-len(et.Items)+1)...)
+	}
+
+	if et.Items[index] == nil {
+		et.Items[index] = &ErrorTree{}
+	}
+
+	return et.Items[index]
+}
+
+// ErrorTree returns errors of changeset arranged by path.
+func (c Changeset) ErrorTree() *ErrorTree {
+	tree := &ErrorTree{}
+
+	for _, err := range c.errors {
+		var (
+			e    = toError(err)
+			node = tree
+		)
+
+		for _, segment := range e.FieldPath() {
+			switch s := segment.(type) {
+			case int:
+				node = node.item(s)
+			case string:
+				node = node.field(s)
+			}
+		}
+
+		node.Errors = append(node.Errors, e)
+	}
+
+	return tree
+}
+
+// ErrorsFor returns errors located at the given path or nested inside it.
+func (c Changeset) ErrorsFor(path Path) []Error {
+	var errs []Error
+
+	for _, err := range c.errors {
+		if e := toError(err); e.FieldPath().HasPrefix(path) {
+			errs = append(errs, e)
+		}
+	}
+
+	return errs
+}
+
+func toError(err error) Error {
+	if e, ok := err.(Error); ok {
+		return e
+	}
+
+	return Error{Message: err.Error(), Err: err}
+}
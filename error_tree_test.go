@@ -0,0 +1,99 @@
+package changeset
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-rel/changeset/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPath_String(t *testing.T) {
+	assert.Equal(t, "", Path{}.String())
+	assert.Equal(t, "name", Path{"name"}.String())
+	assert.Equal(t, "items[2].name", Path{"items", 2, "name"}.String())
+	assert.Equal(t, "address.tags[0]", Path{"address", "tags", 0}.String())
+}
+
+func TestPath_HasPrefix(t *testing.T) {
+	path := Path{"items", 2, "name"}
+	assert.True(t, path.HasPrefix(nil))
+	assert.True(t, path.HasPrefix(Path{"items"}))
+	assert.True(t, path.HasPrefix(Path{"items", 2}))
+	assert.True(t, path.HasPrefix(Path{"items", 2, "name"}))
+	assert.False(t, path.HasPrefix(Path{"items", 1}))
+	assert.False(t, path.HasPrefix(Path{"items", 2, "name", "first"}))
+}
+
+func TestChangeset_ErrorTree(t *testing.T) {
+	var (
+		wrapped = errors.New("wrapped")
+		data    struct {
+			Field1 int
+			Field3 Inner
+			Field4 []Inner
+		}
+		changeInner = func(data interface{}, input params.Params) *Changeset {
+			ch := Cast(data, input, []string{"field4", "field5"})
+			ch.errors = append(ch.errors, Error{Message: "field5 is taken", Field: "field5", Code: 10, Err: wrapped})
+			return ch
+		}
+		input = params.Map{
+			"field1": "1",
+			"field3": params.Map{
+				"field4": "4",
+			},
+			"field4": []params.Map{
+				{"field4": 1},
+				{"field4": "2"},
+			},
+		}
+	)
+
+	ch := Cast(data, input, []string{"field1"})
+	CastAssoc(ch, "field3", changeInner)
+	CastAssoc(ch, "field4", changeInner)
+
+	var (
+		castArgs    = func(field string) map[string]interface{} { return map[string]interface{}{"field": field} }
+		field1Error = Error{Message: "field1 is invalid", Field: "field1", Key: CastErrorKey, Args: castArgs("field1")}
+		field3Error = Error{Message: "field4 is invalid", Field: "field3.field4", Path: Path{"field3", "field4"}, Key: CastErrorKey, Args: castArgs("field4")}
+		field5Error = func(path ...interface{}) Error {
+			return Error{Message: "field5 is taken", Field: Path(path).String(), Path: path, Code: 10, Err: wrapped}
+		}
+		field4Error = Error{Message: "field4 is invalid", Field: "field4[1].field4", Path: Path{"field4", 1, "field4"}, Key: CastErrorKey, Args: castArgs("field4")}
+	)
+
+	assert.Equal(t, &ErrorTree{
+		Fields: map[string]*ErrorTree{
+			"field1": {Errors: []Error{field1Error}},
+			"field3": {
+				Fields: map[string]*ErrorTree{
+					"field4": {Errors: []Error{field3Error}},
+					"field5": {Errors: []Error{field5Error("field3", "field5")}},
+				},
+			},
+			"field4": {
+				Items: []*ErrorTree{
+					{
+						Fields: map[string]*ErrorTree{
+							"field5": {Errors: []Error{field5Error("field4", 0, "field5")}},
+						},
+					},
+					{
+						Fields: map[string]*ErrorTree{
+							"field4": {Errors: []Error{field4Error}},
+							"field5": {Errors: []Error{field5Error("field4", 1, "field5")}},
+						},
+					},
+				},
+			},
+		},
+	}, ch.ErrorTree())
+
+	assert.Equal(t, []Error{field1Error}, ch.ErrorsFor(Path{"field1"}))
+	assert.Equal(t, []Error{field4Error, field5Error("field4", 1, "field5")}, ch.ErrorsFor(Path{"field4", 1}))
+	assert.Equal(t, []Error{field5Error("field3", "field5")}, ch.ErrorsFor(Path{"field3", "field5"}))
+	assert.Nil(t, ch.ErrorsFor(Path{"field2"}))
+	assert.True(t, errors.Is(ch.ErrorsFor(Path{"field3", "field5"})[0], wrapped))
+}
@@ -9,6 +9,9 @@ import (
 // ForeignKeyConstraintMessage is the default error message for ForeignKeyConstraint.
 var ForeignKeyConstraintMessage = "does not exist"
 
+// ForeignKeyConstraintKey is the message key of ForeignKeyConstraint error.
+const ForeignKeyConstraintKey = "foreign_key_constraint"
+
 // ForeignKeyConstraint adds an unique constraint to changeset.
 func ForeignKeyConstraint(ch *Changeset, field string, opts ...Option) {
 	options := Options{
@@ -21,9 +24,11 @@ func ForeignKeyConstraint(ch *Changeset, field string, opts ...Option) {
 	ch.constraints = append(ch.constraints, Constraint{
 		Field:   field,
 		Message: strings.Replace(options.message, "{field}", field, 1),
+		Key:     ForeignKeyConstraintKey,
 		Code:    options.code,
 		Name:    options.name,
 		Exact:   options.exact,
+		custom:  options.customMessage,
 		Type:    rel.ForeignKeyConstraint,
 	})
 }
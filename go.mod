@@ -5,10 +5,13 @@ go 1.23.0
 toolchain go1.24.1
 
 require (
+	github.com/BurntSushi/toml v1.6.0
 	github.com/azer/snakecase v1.0.0
 	github.com/go-rel/rel v0.42.0
 	github.com/stretchr/testify v1.11.0
 	github.com/tidwall/gjson v1.18.0
+	google.golang.org/protobuf v1.36.12
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -25,5 +28,4 @@ require (
 	golang.org/x/sys v0.31.0 // indirect
 	gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127 // indirect
 	gopkg.in/yaml.v2 v2.3.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -1,15 +1,30 @@
 package changeset
 
+import (
+	"reflect"
+	"time"
+
+	"github.com/go-rel/changeset/params"
+)
+
 // Options applicable to changeset.
 type Options struct {
-	message     string
-	code        int
-	name        string
-	exact       bool
-	changeOnly  bool
-	required    bool
-	sourceField string
-	emptyValues []interface{}
+	message       string
+	customMessage bool
+	code          int
+	name          string
+	exact         bool
+	changeOnly    bool
+	required      bool
+	sourceField   string
+	emptyValues   []interface{}
+	primaryKey    string
+	onReplace     ReplacePolicy
+	deleteField   string
+	notNull       []string
+	converters    params.Converters
+	strict        bool
+	limits        params.Limits
 }
 
 // Option for changeset operation.
@@ -25,6 +40,7 @@ func (options *Options) apply(opts []Option) {
 func Message(message string) Option {
 	return func(opts *Options) {
 		opts.message = message
+		opts.customMessage = true
 	}
 }
 
@@ -77,3 +93,75 @@ func EmptyValues(values ...interface{}) Option {
 		opts.emptyValues = values
 	}
 }
+
+// PrimaryKey defines the field used to match params with existing has many association when casting association.
+// default to primary field of the association.
+func PrimaryKey(field string) Option {
+	return func(opts *Options) {
+		opts.primaryKey = field
+	}
+}
+
+// OnReplace defines what happens to existing has many association that is not present in params when casting association.
+// default to ReplaceDelete.
+func OnReplace(policy ReplacePolicy) Option {
+	return func(opts *Options) {
+		opts.onReplace = policy
+	}
+}
+
+// DeleteField defines params field that marks an association to be deleted when casting association.
+//
+//	changeset.CastAssoc(ch, "items", changeItem, changeset.DeleteField("_delete"))
+//
+// Has many association marked with {"id": 5, "_delete": true} will be deleted when the changeset is applied.
+// Has one and belongs to association can't be deleted by repository, marking it adds CastAssocDeleteErrorKey error instead.
+func DeleteField(field string) Option {
+	return func(opts *Options) {
+		opts.deleteField = field
+	}
+}
+
+// NotNull defines fields that can't be set to null when casting.
+func NotNull(fields ...string) Option {
+	return func(opts *Options) {
+		opts.notNull = fields
+	}
+}
+
+// Converter overrides converter registered using params.RegisterConverter for the type when casting.
+func Converter(typ reflect.Type, fn params.ConvertFunc) Option {
+	return func(opts *Options) {
+		if opts.converters == nil {
+			opts.converters = make(params.Converters)
+		}
+
+		opts.converters[typ] = fn
+	}
+}
+
+// TimeFormat overrides params.DefaultTimeFormat when casting time.
+//
+//	changeset.Cast(data, params, fields, changeset.TimeFormat(params.TimeFormat{
+//		Layouts:  []string{"2006-01-02", "2006-01-02T15:04"},
+//		Location: loc,
+//		Epoch:    params.EpochMillis,
+//	}))
+func TimeFormat(format params.TimeFormat) Option {
+	return Converter(reflect.TypeOf(time.Time{}), format.Convert)
+}
+
+// Strict adds an error for every params key that is not permitted when casting.
+// Keys used by CastAssoc and CastEmbed are permitted, and their params are checked as well.
+func Strict() Option {
+	return func(opts *Options) {
+		opts.strict = true
+	}
+}
+
+// Limit restricts the size of association params casted using CastAssoc or CastEmbed, including its nested params.
+func Limit(limits params.Limits) Option {
+	return func(opts *Options) {
+		opts.limits = limits
+	}
+}
@@ -1,8 +1,10 @@
 package changeset
 
 import (
+	"reflect"
 	"testing"
 
+	"github.com/go-rel/changeset/params"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,6 +19,13 @@ func TestOptions(t *testing.T) {
 		Required(true),
 		SourceField("src"),
 		EmptyValues("", 0),
+		PrimaryKey("uuid"),
+		OnReplace(ReplaceKeep),
+		DeleteField("_delete"),
+		NotNull("name"),
+		Converter(reflect.TypeOf(0), func(raw interface{}) (interface{}, bool) { return 0, true }),
+		Strict(),
+		Limit(params.Limits{MaxDepth: 2}),
 	})
 
 	assert.Equal(t, "message", opts.message)
@@ -27,4 +36,12 @@ func TestOptions(t *testing.T) {
 	assert.Equal(t, true, opts.required)
 	assert.Equal(t, "src", opts.sourceField)
 	assert.Equal(t, []interface{}{"", 0}, opts.emptyValues)
+	assert.Equal(t, "uuid", opts.primaryKey)
+	assert.Equal(t, ReplaceKeep, opts.onReplace)
+	assert.Equal(t, "_delete", opts.deleteField)
+	assert.Equal(t, []string{"name"}, opts.notNull)
+	assert.Len(t, opts.converters, 1)
+	assert.Contains(t, opts.converters, reflect.TypeOf(0))
+	assert.Equal(t, true, opts.strict)
+	assert.Equal(t, params.Limits{MaxDepth: 2}, opts.limits)
 }
@@ -0,0 +1,89 @@
+package params
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+)
+
+var (
+	casterType          = reflect.TypeOf((*Caster)(nil)).Elem()
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// Caster is implemented by field type that can parse itself from raw param value.
+// CastParam is called on pointer to a new value, raw value is string for Form,
+// decoded json value (string, float64, bool, []interface{} or map[string]interface{}) for JSON
+// and the value as is for Map.
+type Caster interface {
+	CastParam(raw interface{}) error
+}
+
+// castable returns true if pointer to the type implements Caster, json.Unmarshaler or encoding.TextUnmarshaler.
+// time.Time is excluded since it's handled by each params.
+func castable(typ reflect.Type) bool {
+	if typ == timeType {
+		return false
+	}
+
+	ptr := reflect.PtrTo(typ)
+	return ptr.Implements(casterType) || ptr.Implements(jsonUnmarshalerType) || ptr.Implements(textUnmarshalerType)
+}
+
+// castableFrom returns true if value of rt needs to be casted to castable type,
+// value that can be converted directly is converted instead, eg: []byte to net.IP.
+func castableFrom(rt reflect.Type, typ reflect.Type) bool {
+	if rt == typ || (rt.Kind() == typ.Kind() && rt.ConvertibleTo(typ)) {
+		return false
+	}
+
+	return castable(typ)
+}
+
+// castCustom converts raw value to the type using Caster, encoding.TextUnmarshaler or json.Unmarshaler in that order.
+// TextUnmarshaler is only used for string and number value, and json.Unmarshaler is preferred over it when raw json is available.
+// raw value is encoded as json for json.Unmarshaler when raw json is not available.
+func castCustom(typ reflect.Type, raw interface{}, rawJSON []byte) (interface{}, bool) {
+	var (
+		rv         = reflect.New(typ)
+		err        error
+		str, isStr = raw.(string)
+	)
+
+	switch v := rv.Interface().(type) {
+	case Caster:
+		err = v.CastParam(raw)
+	case encoding.TextUnmarshaler:
+		if u, ok := v.(json.Unmarshaler); ok && (!isStr || rawJSON != nil) {
+			err = unmarshalJSON(u, raw, rawJSON)
+		} else if isStr {
+			err = v.UnmarshalText([]byte(str))
+		} else if text, ok := numberText(raw, rawJSON); ok {
+			err = v.UnmarshalText([]byte(text))
+		} else {
+			return nil, false
+		}
+	case json.Unmarshaler:
+		err = unmarshalJSON(v, raw, rawJSON)
+	default:
+		return nil, false
+	}
+
+	if err != nil {
+		return nil, false
+	}
+
+	return rv.Elem().Interface(), true
+}
+
+func unmarshalJSON(u json.Unmarshaler, raw interface{}, rawJSON []byte) error {
+	if rawJSON == nil {
+		var err error
+		if rawJSON, err = json.Marshal(raw); err != nil {
+			return err
+		}
+	}
+
+	return u.UnmarshalJSON(rawJSON)
+}
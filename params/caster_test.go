@@ -0,0 +1,180 @@
+package params_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-rel/changeset/params"
+	"github.com/stretchr/testify/assert"
+)
+
+// Hex implements encoding.TextUnmarshaler.
+type Hex [2]byte
+
+func (h *Hex) UnmarshalText(text []byte) error {
+	b, err := hex.DecodeString(string(text))
+	if err != nil || len(b) != 2 {
+		return errors.New("invalid hex")
+	}
+
+	copy(h[:], b)
+	return nil
+}
+
+// Level implements params.Caster.
+type Level int
+
+func (l *Level) CastParam(raw interface{}) error {
+	switch v := raw.(type) {
+	case string:
+		switch strings.ToLower(v) {
+		case "low":
+			*l = 1
+		case "high":
+			*l = 2
+		default:
+			return errors.New("invalid level")
+		}
+	case float64:
+		*l = Level(v)
+	case int:
+		*l = Level(v)
+	default:
+		return errors.New("invalid level")
+	}
+
+	return nil
+}
+
+// Point implements json.Unmarshaler.
+type Point struct {
+	X, Y int
+}
+
+func (p *Point) UnmarshalJSON(data []byte) error {
+	var xy []int
+	if err := json.Unmarshal(data, &xy); err != nil || len(xy) != 2 {
+		return errors.New("invalid point")
+	}
+
+	p.X, p.Y = xy[0], xy[1]
+	return nil
+}
+
+var (
+	hexType   = reflect.TypeOf(Hex{})
+	levelType = reflect.TypeOf(Level(0))
+	pointType = reflect.TypeOf(Point{})
+	ipType    = reflect.TypeOf(net.IP{})
+)
+
+func TestMap_GetWithType_caster(t *testing.T) {
+	p := params.Map{
+		"hex":         "0aff",
+		"hex_value":   Hex{1, 2},
+		"hex_invalid": "zz",
+		"level":       "high",
+		"levels":      []interface{}{"low", 2},
+		"point":       []int{1, 2},
+		"ip":          "127.0.0.1",
+		"ip_bytes":    []byte{127, 0, 0, 1},
+	}
+
+	tests := []struct {
+		name     string
+		typ      reflect.Type
+		expected interface{}
+		valid    bool
+	}{
+		{"hex", hexType, Hex{0x0a, 0xff}, true},
+		{"hex_value", hexType, Hex{1, 2}, true},
+		{"hex_invalid", hexType, nil, false},
+		{"level", levelType, Level(2), true},
+		{"levels", reflect.TypeOf([]Level{}), []Level{1, 2}, true},
+		{"point", pointType, Point{X: 1, Y: 2}, true},
+		{"ip", ipType, net.ParseIP("127.0.0.1"), true},
+		{"ip_bytes", ipType, net.IP{127, 0, 0, 1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, valid := p.GetWithType(tt.name, tt.typ)
+			assert.Equal(t, tt.valid, valid)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestForm_GetWithType_caster(t *testing.T) {
+	p := params.ParseForm(url.Values{
+		"hex":         {"0aff"},
+		"hex_invalid": {"zz"},
+		"level":       {"LOW"},
+		"levels":      {"low", "high"},
+		"point":       {"[1, 2]"},
+		"ip":          {"::1"},
+	})
+
+	tests := []struct {
+		name     string
+		typ      reflect.Type
+		expected interface{}
+		valid    bool
+	}{
+		{"hex", hexType, Hex{0x0a, 0xff}, true},
+		{"hex_invalid", hexType, nil, false},
+		{"level", levelType, Level(1), true},
+		{"levels", reflect.TypeOf([]Level{}), []Level{1, 2}, true},
+		{"point", pointType, nil, false},
+		{"ip", ipType, net.ParseIP("::1"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, valid := p.GetWithType(tt.name, tt.typ)
+			assert.Equal(t, tt.valid, valid)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestJSON_GetWithType_caster(t *testing.T) {
+	p := params.ParseJSON(`{
+		"hex": "0aff",
+		"hex_number": 10,
+		"level": 2,
+		"levels": ["low", 2],
+		"point": [1, 2],
+		"point_invalid": "1,2",
+		"ip": "10.0.0.1"
+	}`)
+
+	tests := []struct {
+		name     string
+		typ      reflect.Type
+		expected interface{}
+		valid    bool
+	}{
+		{"hex", hexType, Hex{0x0a, 0xff}, true},
+		{"hex_number", hexType, nil, false},
+		{"level", levelType, Level(2), true},
+		{"levels", reflect.TypeOf([]Level{}), []Level{1, 2}, true},
+		{"point", pointType, Point{X: 1, Y: 2}, true},
+		{"point_invalid", pointType, nil, false},
+		{"ip", ipType, net.ParseIP("10.0.0.1"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, valid := p.GetWithType(tt.name, tt.typ)
+			assert.Equal(t, tt.valid, valid)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
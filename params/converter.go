@@ -0,0 +1,66 @@
+package params
+
+import (
+	"reflect"
+	"sync"
+)
+
+var converterRegistry sync.Map
+
+// ConvertFunc converts raw param value to a specific type, returns false if the value is not convertible.
+// raw value is the same value passed to Caster.
+type ConvertFunc func(raw interface{}) (interface{}, bool)
+
+// Converters maps type to its converter, used to override registered converters for a single call.
+type Converters map[reflect.Type]ConvertFunc
+
+// RegisterConverter registers converter for the type, used by every params before its built-in conversion.
+// Registering converter for the same type replaces the previous one.
+func RegisterConverter(typ reflect.Type, fn ConvertFunc) {
+	converterRegistry.Store(typ, fn)
+}
+
+// GetWithConverters returns value of given name and type, converters takes precedence over registered converters.
+// Params that doesn't implement ConverterParams will use GetWithType.
+func GetWithConverters(p Params, name string, typ reflect.Type, converters Converters) (interface{}, bool) {
+	if cp, ok := p.(ConverterParams); ok {
+		return cp.GetWithConverters(name, typ, converters)
+	}
+
+	return p.GetWithType(name, typ)
+}
+
+// ConverterParams is implemented by params that convert its value using converters, see GetWithConverters.
+type ConverterParams interface {
+	GetWithConverters(name string, typ reflect.Type, converters Converters) (interface{}, bool)
+}
+
+func lookupConverter(typ reflect.Type, converters Converters) (ConvertFunc, bool) {
+	if fn, ok := converters[typ]; ok {
+		return checkConverted(typ, fn), true
+	}
+
+	if fn, ok := converterRegistry.Load(typ); ok {
+		return checkConverted(typ, fn.(ConvertFunc)), true
+	}
+
+	return nil, false
+}
+
+// checkConverted wraps converter to report value that is not assignable to the type as not convertible.
+func checkConverted(typ reflect.Type, fn ConvertFunc) ConvertFunc {
+	return func(raw interface{}) (interface{}, bool) {
+		value, ok := fn(raw)
+		if ok && value != nil && !reflect.TypeOf(value).AssignableTo(typ) {
+			return nil, false
+		}
+
+		return value, ok
+	}
+}
+
+// customType returns true if the type is converted using converter or Caster instead of built-in conversion.
+func customType(typ reflect.Type, converters Converters) bool {
+	_, ok := lookupConverter(typ, converters)
+	return ok || castable(typ)
+}
@@ -0,0 +1,99 @@
+package params_test
+
+import (
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-rel/changeset/params"
+	"github.com/stretchr/testify/assert"
+)
+
+// Money is a third-party like type that can't implement Caster.
+type Money struct {
+	Cents int64
+}
+
+var moneyType = reflect.TypeOf(Money{})
+
+func convertMoney(raw interface{}) (interface{}, bool) {
+	switch v := raw.(type) {
+	case string:
+		parts := strings.SplitN(v, ".", 2)
+		units, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			return nil, false
+		}
+
+		cents := int64(0)
+		if len(parts) == 2 {
+			if cents, err = strconv.ParseInt(parts[1], 10, 64); err != nil {
+				return nil, false
+			}
+		}
+
+		return Money{Cents: units*100 + cents}, true
+	case float64:
+		return Money{Cents: int64(v * 100)}, true
+	}
+
+	return nil, false
+}
+
+func convertFree(raw interface{}) (interface{}, bool) {
+	return Money{}, true
+}
+
+func init() {
+	params.RegisterConverter(moneyType, convertMoney)
+}
+
+func TestRegisterConverter(t *testing.T) {
+	tests := []struct {
+		name   string
+		params params.Params
+	}{
+		{"map", params.Map{"price": "10.50", "prices": []interface{}{"1.00", "2.25"}, "invalid": "abc"}},
+		{"form", params.ParseForm(url.Values{"price": {"10.50"}, "prices": {"1.00", "2.25"}, "invalid": {"abc"}})},
+		{"json", params.ParseJSON(`{"price": 10.5, "prices": ["1.00", 2.25], "invalid": "abc"}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, valid := tt.params.GetWithType("price", moneyType)
+			assert.True(t, valid)
+			assert.Equal(t, Money{Cents: 1050}, result)
+
+			result, valid = tt.params.GetWithType("prices", reflect.TypeOf([]Money{}))
+			assert.True(t, valid)
+			assert.Equal(t, []Money{{Cents: 100}, {Cents: 225}}, result)
+
+			result, valid = tt.params.GetWithType("invalid", moneyType)
+			assert.False(t, valid)
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestGetWithConverters(t *testing.T) {
+	var (
+		converters = params.Converters{moneyType: convertFree}
+		tests      = []params.Params{
+			params.Map{"price": "10.50"},
+			params.ParseForm(url.Values{"price": {"10.50"}}),
+			params.ParseJSON(`{"price": 10.5}`),
+		}
+	)
+
+	for _, p := range tests {
+		result, valid := params.GetWithConverters(p, "price", moneyType, converters)
+		assert.True(t, valid)
+		assert.Equal(t, Money{}, result)
+
+		result, valid = params.GetWithConverters(p, "price", moneyType, nil)
+		assert.True(t, valid)
+		assert.Equal(t, Money{Cents: 1050}, result)
+	}
+}
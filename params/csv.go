@@ -0,0 +1,129 @@
+package params
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Positioner is implemented by params that know where its fields are located in the source document.
+type Positioner interface {
+	Position(field string) (row int, column int, ok bool)
+}
+
+// CSVRow is params of a single csv record, keyed by the header line.
+type CSVRow struct {
+	Form
+	// Row is the 1-based line number where the record starts in the file, the header starts at line 1.
+	Row       int
+	positions map[string]csvPosition
+}
+
+type csvPosition struct {
+	line   int
+	column int
+}
+
+var (
+	_ Params     = CSVRow{}
+	_ Positioner = CSVRow{}
+)
+
+// Position returns 1-based line and column of the field in the file, as reported by csv.Reader.FieldPos.
+// Line of the field differs from Row when the record contains quoted field that spans multiple lines.
+// nested field is located using dot and bracket notation, eg: address.city or items[0].name.
+func (row CSVRow) Position(field string) (int, int, bool) {
+	pos, ok := row.positions[field]
+	if !ok {
+		return 0, 0, false
+	}
+
+	return pos.line, pos.column, true
+}
+
+// CSVReader reads csv records as params, using the first record as header.
+// Header is parsed the same way as ParseForm, thus dotted or bracketed header such as address.city
+// is accessible using GetParams.
+type CSVReader struct {
+	// Reader is the underlying csv reader, it can be configured before the first call to Read.
+	Reader *csv.Reader
+	header []string
+}
+
+// NewCSVReader returns a new CSVReader that reads from r.
+func NewCSVReader(r io.Reader) *CSVReader {
+	return &CSVReader{Reader: csv.NewReader(r)}
+}
+
+// Header returns the header line, reading it if it's not read yet.
+func (r *CSVReader) Header() ([]string, error) {
+	if r.header != nil {
+		return r.header, nil
+	}
+
+	header, err := r.Reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	r.header = append([]string(nil), header...)
+
+	return r.header, nil
+}
+
+// Read reads the next record as params, returns io.EOF when there is no more record.
+func (r *CSVReader) Read() (CSVRow, error) {
+	header, err := r.Header()
+	if err != nil {
+		return CSVRow{}, err
+	}
+
+	record, err := r.Reader.Read()
+	if err != nil {
+		return CSVRow{}, err
+	}
+
+	line, _ := r.Reader.FieldPos(0)
+	row := CSVRow{
+		Form:      make(Form, len(header)),
+		Row:       line,
+		positions: make(map[string]csvPosition, len(header)),
+	}
+
+	for i, name := range header {
+		if i >= len(record) {
+			break
+		}
+
+		line, column := r.Reader.FieldPos(i)
+		row.Form.parse(name, []interface{}{record[i]})
+		row.positions[fieldPath(name)] = csvPosition{line: line, column: column}
+	}
+
+	return row, nil
+}
+
+// fieldPath normalizes form key to dot and bracket notation, eg: items.0.name is items[0].name.
+func fieldPath(key string) string {
+	var buffer strings.Builder
+
+	for _, field := range strings.FieldsFunc(key, fieldsExtractor) {
+		if _, err := strconv.Atoi(field); err == nil {
+			buffer.WriteString("[" + field + "]")
+			continue
+		}
+
+		if buffer.Len() > 0 {
+			buffer.WriteByte('.')
+		}
+
+		buffer.WriteString(field)
+	}
+
+	return buffer.String()
+}
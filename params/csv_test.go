@@ -0,0 +1,102 @@
+package params_test
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-rel/changeset/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSVReader(t *testing.T) {
+	r := params.NewCSVReader(strings.NewReader("\ufeffname,age,address.city,items[0].name\n" +
+		"Luffy,19,East Blue,Hat\n" +
+		"Zoro,,\"Shimotsuki\nVillage\",Sword\n" +
+		"Nami,18,Cocoyasi,Clima-Tact\n"))
+
+	header, err := r.Header()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"name", "age", "address.city", "items[0].name"}, header)
+
+	row, err := r.Read()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, row.Row)
+	assert.Equal(t, []string{"address", "age", "items", "name"}, row.Keys())
+
+	age, valid := row.GetWithType("age", reflect.TypeOf(0))
+	assert.True(t, valid)
+	assert.Equal(t, 19, age)
+
+	address, valid := row.GetParams("address")
+	assert.True(t, valid)
+	assert.Equal(t, []interface{}{"East Blue"}, address.Get("city"))
+
+	items, valid := row.GetParamsSlice("items")
+	assert.True(t, valid)
+	assert.Equal(t, []interface{}{"Hat"}, items[0].Get("name"))
+
+	row, err = r.Read()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, row.Row)
+	assert.Equal(t, []interface{}{""}, row.Get("age"))
+
+	address, _ = row.GetParams("address")
+	assert.Equal(t, []interface{}{"Shimotsuki\nVillage"}, address.Get("city"))
+
+	line, column, _ := row.Position("items[0].name")
+	assert.Equal(t, 4, line)
+	assert.Equal(t, 10, column)
+
+	row, err = r.Read()
+	assert.Nil(t, err)
+	assert.Equal(t, 5, row.Row)
+
+	_, err = r.Read()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestCSVRow_Position(t *testing.T) {
+	r := params.NewCSVReader(strings.NewReader("name,address[city],items.0.name\nLuffy,East Blue,Hat\n"))
+
+	row, err := r.Read()
+	assert.Nil(t, err)
+
+	tests := []struct {
+		field  string
+		row    int
+		column int
+		ok     bool
+	}{
+		{"name", 2, 1, true},
+		{"address.city", 2, 7, true},
+		{"items[0].name", 2, 17, true},
+		{"address", 0, 0, false},
+		{"not-exists", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			row, column, ok := row.Position(tt.field)
+			assert.Equal(t, tt.row, row)
+			assert.Equal(t, tt.column, column)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
+
+func TestCSVReader_error(t *testing.T) {
+	_, err := params.NewCSVReader(strings.NewReader("")).Read()
+	assert.Equal(t, io.EOF, err)
+
+	r := params.NewCSVReader(strings.NewReader("name,age\nLuffy\n"))
+	_, err = r.Read()
+	assert.NotNil(t, err)
+
+	r = params.NewCSVReader(strings.NewReader("name;age\nLuffy;19\n"))
+	r.Reader.Comma = ';'
+	row, err := r.Read()
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"19"}, row.Get("age"))
+}
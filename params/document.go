@@ -0,0 +1,44 @@
+package params
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ParseDocument converts document decoded into map[string]interface{}, such as YAML or TOML, to JSON params,
+// so it shares the same conversion rules.
+func ParseDocument(doc interface{}) (Params, error) {
+	data, err := json.Marshal(normalizeDocument(doc))
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseJSON(string(data)), nil
+}
+
+// normalizeDocument converts map with non string keys to map[string]interface{}, so it can be encoded as json.
+func normalizeDocument(doc interface{}) interface{} {
+	switch v := doc.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = normalizeDocument(value)
+		}
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[fmt.Sprint(key)] = normalizeDocument(value)
+		}
+
+		return m
+	case []interface{}:
+		for i := range v {
+			v[i] = normalizeDocument(v[i])
+		}
+	case []map[string]interface{}:
+		for i := range v {
+			normalizeDocument(v[i])
+		}
+	}
+
+	return doc
+}
@@ -0,0 +1,30 @@
+package params
+
+import (
+	"os"
+	"strings"
+)
+
+// FromEnv returns environment variables that starts with the given prefix as Form.
+// Variable name is lowercased after its prefix is removed, and double underscore is used for nesting,
+// eg: PREFIX_DB__HOST is accessible as host of db params, and PREFIX_DB__REPLICAS__0__HOST as host of the first replica.
+// Empty prefix uses all environment variables.
+// Variable that nests into another variable is ignored, eg: PREFIX_DB__HOST is ignored when PREFIX_DB is set.
+func FromEnv(prefix string) Form {
+	if prefix = strings.TrimSuffix(prefix, "_"); prefix != "" {
+		prefix += "_"
+	}
+
+	values := make(map[string]string)
+
+	for _, env := range os.Environ() {
+		name, value, ok := strings.Cut(env, "=")
+		if !ok || !strings.HasPrefix(name, prefix) || len(name) == len(prefix) {
+			continue
+		}
+
+		values[strings.ReplaceAll(strings.ToLower(name[len(prefix):]), "__", ".")] = value
+	}
+
+	return parseSingle(values)
+}
@@ -0,0 +1,59 @@
+package params_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-rel/changeset/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("APP_NAME", "changeset")
+	t.Setenv("APP_LOG_LEVEL", "debug")
+	t.Setenv("APP_DB__HOST", "localhost")
+	t.Setenv("APP_DB__PORT", "5432")
+	t.Setenv("APP_DB__REPLICAS__0__HOST", "replica")
+	t.Setenv("APP_", "empty")
+	t.Setenv("APPLICATION", "other")
+	t.Setenv("OTHER_NAME", "other")
+
+	for _, prefix := range []string{"APP", "APP_"} {
+		t.Run(prefix, func(t *testing.T) {
+			p := params.FromEnv(prefix)
+
+			assert.Equal(t, []string{"db", "log_level", "name"}, p.Keys())
+			assert.Equal(t, []interface{}{"changeset"}, p.Get("name"))
+			assert.Equal(t, []interface{}{"debug"}, p.Get("log_level"))
+
+			db, valid := p.GetParams("db")
+			assert.True(t, valid)
+
+			port, valid := db.GetWithType("port", reflect.TypeOf(0))
+			assert.True(t, valid)
+			assert.Equal(t, 5432, port)
+
+			replicas, valid := db.GetParamsSlice("replicas")
+			assert.True(t, valid)
+			assert.Equal(t, []interface{}{"replica"}, replicas[0].Get("host"))
+		})
+	}
+}
+
+func TestFromEnv_noPrefix(t *testing.T) {
+	t.Setenv("CHANGESET_TEST_ENV", "value")
+
+	p := params.FromEnv("")
+	assert.Equal(t, []interface{}{"value"}, p.Get("changeset_test_env"))
+}
+
+func TestFromEnv_nestedScalar(t *testing.T) {
+	t.Setenv("APP_DB", "postgres")
+	t.Setenv("APP_DB__HOST", "localhost")
+
+	p := params.FromEnv("APP")
+	assert.Equal(t, []interface{}{"postgres"}, p.Get("db"))
+
+	_, valid := p.GetParams("db")
+	assert.False(t, valid)
+}
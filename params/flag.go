@@ -0,0 +1,20 @@
+package params
+
+import (
+	"flag"
+	"strings"
+)
+
+// FromFlagSet returns flags that are explicitly set as Form, flags that use default value are absent.
+// Dash in flag name is replaced with underscore, and dot is used for nesting,
+// eg: -db.max-conns is accessible as max_conns of db params.
+// Flag that nests into another flag is ignored, eg: -db.host is ignored when -db is set.
+func FromFlagSet(fs *flag.FlagSet) Form {
+	values := make(map[string]string)
+
+	fs.Visit(func(f *flag.Flag) {
+		values[strings.ReplaceAll(f.Name, "-", "_")] = f.Value.String()
+	})
+
+	return parseSingle(values)
+}
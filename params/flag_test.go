@@ -0,0 +1,54 @@
+package params_test
+
+import (
+	"flag"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-rel/changeset/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromFlagSet(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.String("name", "default", "")
+	fs.Bool("debug", false, "")
+	fs.Duration("timeout", time.Second, "")
+	fs.String("db.host", "localhost", "")
+	fs.Int("db.max-conns", 10, "")
+
+	assert.Nil(t, fs.Parse([]string{"-debug", "-timeout=1m", "-db.max-conns", "20"}))
+
+	p := params.FromFlagSet(fs)
+	assert.Equal(t, []string{"db", "debug", "timeout"}, p.Keys())
+	assert.False(t, p.Exists("name"))
+
+	debug, valid := p.GetWithType("debug", reflect.TypeOf(true))
+	assert.True(t, valid)
+	assert.Equal(t, true, debug)
+
+	timeout, valid := p.GetWithType("timeout", reflect.TypeOf(time.Duration(0)))
+	assert.True(t, valid)
+	assert.Equal(t, time.Minute, timeout)
+
+	db, valid := p.GetParams("db")
+	assert.True(t, valid)
+	assert.False(t, db.Exists("host"))
+
+	maxConns, valid := db.GetWithType("max_conns", reflect.TypeOf(0))
+	assert.True(t, valid)
+	assert.Equal(t, 20, maxConns)
+}
+
+func TestFromFlagSet_nestedScalar(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.String("db", "", "")
+	fs.String("db.host", "", "")
+
+	assert.Nil(t, fs.Parse([]string{"-db.host", "localhost", "-db", "postgres"}))
+
+	p := params.FromFlagSet(fs)
+	assert.Equal(t, []string{"db"}, p.Keys())
+	assert.Equal(t, []interface{}{"postgres"}, p.Get("db"))
+}
@@ -1,11 +1,12 @@
 package params
 
 import (
+	"mime/multipart"
 	"net/url"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
-	"time"
 )
 
 // Form is param type alias for url.Values
@@ -13,12 +14,40 @@ type Form map[string][]interface{}
 
 var _ Params = (*Form)(nil)
 
+var fileHeaderType = reflect.TypeOf(multipart.FileHeader{})
+
 // Exists returns true if key exists.
 func (form Form) Exists(name string) bool {
 	_, exists := form[name]
 	return exists
 }
 
+// Presence returns whether key is absent, explicitly null or has a value.
+// key without any value or with a single nil value is considered as null.
+func (form Form) Presence(name string) Presence {
+	value, exists := form[name]
+	if !exists {
+		return Absent
+	}
+
+	if len(value) == 0 || (len(value) == 1 && value[0] == nil) {
+		return Null
+	}
+
+	return Present
+}
+
+// Keys returns sorted keys of the form.
+func (form Form) Keys() []string {
+	keys := make([]string, 0, len(form))
+	for key := range form {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
 // Get returns value as interface.
 // returns nil if value doens't exists.
 // the value returned is slice of interface{}
@@ -35,6 +64,11 @@ func (form Form) Get(name string) interface{} {
 // If value is not convertible to type, it'll return nil, false
 // If value is not exists, it will return nil, true
 func (form Form) GetWithType(name string, typ reflect.Type) (interface{}, bool) {
+	return form.GetWithConverters(name, typ, nil)
+}
+
+// GetWithConverters returns value given from given name and type, converted using converters when available.
+func (form Form) GetWithConverters(name string, typ reflect.Type, converters Converters) (interface{}, bool) {
 	value, exist := form[name]
 	if !exist {
 		return nil, true
@@ -42,34 +76,56 @@ func (form Form) GetWithType(name string, typ reflect.Type) (interface{}, bool)
 
 	result, valid := interface{}(nil), false
 
-	if typ.Kind() == reflect.Slice {
+	if typ.Kind() == reflect.Slice && !customType(typ, converters) {
 		rv := reflect.MakeSlice(typ, len(value), len(value))
 		elmType := typ.Elem()
 
 		for i, elm := range value {
-			if str, ok := elm.(string); ok {
-				elmValue, valid := form.convert(str, elmType)
-				if !valid {
-					return nil, false
-				}
-
-				rv.Index(i).Set(reflect.ValueOf(elmValue))
-			} else {
+			elmValue, valid := form.convertValue(elm, elmType, converters)
+			if !valid {
 				return nil, false
 			}
+
+			rv.Index(i).Set(reflect.ValueOf(elmValue))
 		}
 
 		result, valid = rv.Interface(), true
 	} else if len(value) > 0 {
-		if str, ok := value[0].(string); ok {
-			result, valid = form.convert(str, typ)
-		}
+		result, valid = form.convertValue(value[0], typ, converters)
 	}
 
 	return result, valid
 }
 
-func (form Form) convert(str string, typ reflect.Type) (interface{}, bool) {
+// convertValue converts string value, or uploaded file to multipart.FileHeader.
+func (form Form) convertValue(value interface{}, typ reflect.Type, converters Converters) (interface{}, bool) {
+	switch v := value.(type) {
+	case string:
+		return form.convert(v, typ, converters)
+	case *multipart.FileHeader:
+		if typ == fileHeaderType {
+			return *v, true
+		}
+	}
+
+	return nil, false
+}
+
+func (form Form) convert(str string, typ reflect.Type, converters Converters) (interface{}, bool) {
+	if convert, ok := lookupConverter(typ, converters); ok {
+		return convert(str)
+	}
+
+	if castable(typ) {
+		return castCustom(typ, str, nil)
+	}
+
+	if typ == durationType {
+		if result, valid := convertDuration(str); valid {
+			return result, true
+		}
+	}
+
 	result := interface{}(nil)
 	valid := false
 
@@ -119,7 +175,7 @@ func (form Form) convert(str string, typ reflect.Type) (interface{}, bool) {
 			result, valid = uint64(parsed), true
 		}
 	case reflect.Uintptr:
-		if parsed, err := strconv.ParseUint(str, 10, 8); err == nil {
+		if parsed, err := strconv.ParseUint(str, 10, 0); err == nil {
 			result, valid = uintptr(parsed), true
 		}
 	case reflect.Float32:
@@ -134,9 +190,7 @@ func (form Form) convert(str string, typ reflect.Type) (interface{}, bool) {
 		result, valid = str, true
 	case reflect.Struct:
 		if typ == timeType {
-			if parsed, err := time.Parse(time.RFC3339, str); err == nil {
-				result, valid = parsed, true
-			}
+			result, valid = DefaultTimeFormat.Convert(str)
 		}
 	}
 
@@ -190,10 +244,8 @@ func (form Form) assigns(pfield string, cfield string, index int, values interfa
 			form = form[pfield][0].(Form)
 		}
 
-		if stringValues, ok := values.([]string); ok {
-			for _, value := range stringValues {
-				form[cfield] = append(form[cfield], value)
-			}
+		if values, ok := values.([]interface{}); ok {
+			form[cfield] = append(form[cfield], values...)
 		}
 	} else {
 		// expand
@@ -212,45 +264,110 @@ func (form Form) assigns(pfield string, cfield string, index int, values interfa
 }
 
 // ParseForm form from url values.
+// It does not restrict the size of params, use Limits.ParseForm for untrusted input.
 func ParseForm(raw url.Values) Form {
 	result := make(Form, len(raw))
 
 	for k, v := range raw {
-		if len(v) == 0 {
+		values := make([]interface{}, len(v))
+		for i := range v {
+			values[i] = v[i]
+		}
+
+		result.parse(k, values)
+	}
+
+	return result
+}
+
+// ParseMultipartForm form from multipart form, nested the same way as ParseForm.
+// Uploaded files are assigned as *multipart.FileHeader, which can be casted to multipart.FileHeader field.
+func ParseMultipartForm(raw *multipart.Form) Form {
+	result := ParseForm(raw.Value)
+
+	for k, v := range raw.File {
+		values := make([]interface{}, len(v))
+		for i := range v {
+			values[i] = v[i]
+		}
+
+		result.parse(k, values)
+	}
+
+	return result
+}
+
+// parseSingle parses key and single value pairs in sorted order, such as environment variables or flags.
+// key that nests into another key with scalar value is skipped, eg: db.host is skipped when db is set.
+func parseSingle(values map[string]string) Form {
+	var (
+		keys    = make([]string, 0, len(values))
+		scalars = make(map[string]bool, len(values))
+		result  = make(Form, len(values))
+	)
+
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	// key always comes before the keys nested into it.
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fields := strings.FieldsFunc(key, fieldsExtractor)
+		if len(fields) == 0 || nestsScalar(scalars, fields) {
 			continue
 		}
 
-		fields := strings.FieldsFunc(k, fieldsExtractor)
+		scalars[strings.Join(fields, ".")] = true
+		result.parse(key, []interface{}{values[key]})
+	}
+
+	return result
+}
+
+func nestsScalar(scalars map[string]bool, fields []string) bool {
+	for i := 1; i < len(fields); i++ {
+		if scalars[strings.Join(fields[:i], ".")] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (form Form) parse(key string, values []interface{}) {
+	if len(values) == 0 {
+		return
+	}
 
-		pfield, cfield := "", ""
-		form := result
-		for i := range fields {
-			pfield = cfield
-			cfield = fields[i]
+	fields := strings.FieldsFunc(key, fieldsExtractor)
 
-			if index, err := strconv.Atoi(cfield); err == nil {
-				if i == len(fields)-1 {
-					form.assigns(pfield, "", index, v[0])
-				} else {
-					form.assigns(pfield, "", index, nil)
-					form = form[pfield][index].(Form)
-					cfield = "" // set cfield empty, so unnecesary nesting wont be created in the next loop
-				}
+	pfield, cfield := "", ""
+	for i := range fields {
+		pfield = cfield
+		cfield = fields[i]
+
+		if index, err := strconv.Atoi(cfield); err == nil {
+			if i == len(fields)-1 {
+				form.assigns(pfield, "", index, values[0])
 			} else {
-				if i == len(fields)-1 {
-					form.assigns(pfield, cfield, -1, v)
-				} else {
-					form.assigns(pfield, cfield, -1, nil)
-					if pfield != "" {
-						index := len(form[pfield]) - 1
-						form = form[pfield][index].(Form)
-					}
+				form.assigns(pfield, "", index, nil)
+				form = form[pfield][index].(Form)
+				cfield = "" // set cfield empty, so unnecesary nesting wont be created in the next loop
+			}
+		} else {
+			if i == len(fields)-1 {
+				form.assigns(pfield, cfield, -1, values)
+			} else {
+				form.assigns(pfield, cfield, -1, nil)
+				if pfield != "" {
+					index := len(form[pfield]) - 1
+					form = form[pfield][index].(Form)
 				}
 			}
 		}
 	}
-
-	return result
 }
 
 func fieldsExtractor(c rune) bool {
@@ -1,6 +1,9 @@
 package params_test
 
 import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
 	"net/url"
 	"reflect"
 	"testing"
@@ -19,6 +22,30 @@ func TestForm_Exists(t *testing.T) {
 	assert.False(t, p.Exists("not-exists"))
 }
 
+func TestForm_Presence(t *testing.T) {
+	p := params.Form{
+		"value": {"a"},
+		"empty": {},
+		"null":  {nil},
+	}
+
+	assert.Equal(t, params.Present, p.Presence("value"))
+	assert.Equal(t, params.Null, p.Presence("empty"))
+	assert.Equal(t, params.Null, p.Presence("null"))
+	assert.Equal(t, params.Absent, p.Presence("not-exists"))
+}
+
+func TestForm_Keys(t *testing.T) {
+	p := params.ParseForm(url.Values{
+		"b":       {"1"},
+		"a":       {"1"},
+		"c[d]":    {"1"},
+		"e[0][f]": {"1"},
+	})
+
+	assert.Equal(t, []string{"a", "b", "c", "e"}, p.Keys())
+}
+
 func TestForm_Get(t *testing.T) {
 	p := params.ParseForm(url.Values{
 		"exists": []string{"true"},
@@ -575,3 +602,77 @@ func TestParseForm(t *testing.T) {
 		})
 	}
 }
+
+func newMultipartForm(t *testing.T, values map[string]string, files map[string][]string) *multipart.Form {
+	var (
+		body   bytes.Buffer
+		writer = multipart.NewWriter(&body)
+	)
+
+	for k, v := range values {
+		assert.Nil(t, writer.WriteField(k, v))
+	}
+
+	for k, contents := range files {
+		for i, content := range contents {
+			w, err := writer.CreateFormFile(k, fmt.Sprintf("file%d.txt", i))
+			assert.Nil(t, err)
+			w.Write([]byte(content))
+		}
+	}
+
+	assert.Nil(t, writer.Close())
+
+	form, err := multipart.NewReader(&body, writer.Boundary()).ReadForm(1 << 20)
+	assert.Nil(t, err)
+
+	return form
+}
+
+func TestParseMultipartForm(t *testing.T) {
+	var (
+		fileHeaderType = reflect.TypeOf(multipart.FileHeader{})
+		raw            = newMultipartForm(t, map[string]string{
+			"name":       "Luffy",
+			"user[role]": "captain",
+		}, map[string][]string{
+			"avatar":          {"avatar"},
+			"user[cover]":     {"cover"},
+			"attachments[]":   {"a", "bc"},
+			"items[0][image]": {"image"},
+		})
+		p = params.ParseMultipartForm(raw)
+	)
+
+	assert.Equal(t, []interface{}{"Luffy"}, p.Get("name"))
+
+	avatar, valid := p.GetWithType("avatar", fileHeaderType)
+	assert.True(t, valid)
+	assert.Equal(t, *raw.File["avatar"][0], avatar)
+
+	attachments, valid := p.GetWithType("attachments", reflect.TypeOf([]multipart.FileHeader{}))
+	assert.True(t, valid)
+	assert.Equal(t, []multipart.FileHeader{*raw.File["attachments[]"][0], *raw.File["attachments[]"][1]}, attachments)
+
+	_, valid = p.GetWithType("avatar", reflect.TypeOf(""))
+	assert.False(t, valid)
+
+	_, valid = p.GetWithType("name", fileHeaderType)
+	assert.False(t, valid)
+
+	user, ok := p.GetParams("user")
+	assert.True(t, ok)
+	assert.Equal(t, []interface{}{"captain"}, user.Get("role"))
+
+	cover, valid := user.GetWithType("cover", fileHeaderType)
+	assert.True(t, valid)
+	assert.Equal(t, *raw.File["user[cover]"][0], cover)
+
+	items, ok := p.GetParamsSlice("items")
+	assert.True(t, ok)
+	assert.Len(t, items, 1)
+
+	image, valid := items[0].GetWithType("image", fileHeaderType)
+	assert.True(t, valid)
+	assert.Equal(t, *raw.File["items[0][image]"][0], image)
+}
@@ -2,8 +2,8 @@ package params
 
 import (
 	"reflect"
+	"strconv"
 	"sync"
-	"time"
 
 	"github.com/tidwall/gjson"
 )
@@ -21,6 +21,33 @@ func (json *JSON) Exists(name string) bool {
 	return json.fetch(name).Exists()
 }
 
+// Presence returns whether key is absent, explicitly null or has a value.
+func (json *JSON) Presence(name string) Presence {
+	value := json.fetch(name)
+	if !value.Exists() {
+		return Absent
+	}
+
+	if value.Type == gjson.Null {
+		return Null
+	}
+
+	return Present
+}
+
+// Keys returns keys of json object in the order they appear.
+func (json *JSON) Keys() []string {
+	var keys []string
+	if json.IsObject() {
+		json.ForEach(func(key, _ gjson.Result) bool {
+			keys = append(keys, key.String())
+			return true
+		})
+	}
+
+	return keys
+}
+
 // Get returns value as interface.
 // returns nil if value doens't exists.
 func (json *JSON) Get(name string) interface{} {
@@ -32,14 +59,19 @@ func (json *JSON) Get(name string) interface{} {
 // If value is not convertible to type, it'll return nil, false
 // If value is not exists, it will return nil, true
 func (json *JSON) GetWithType(name string, typ reflect.Type) (interface{}, bool) {
+	return json.GetWithConverters(name, typ, nil)
+}
+
+// GetWithConverters returns value given from given name and type, converted using converters when available.
+func (json *JSON) GetWithConverters(name string, typ reflect.Type, converters Converters) (interface{}, bool) {
 	value := json.fetch(name)
-	if value.IsArray() && typ.Kind() == reflect.Slice {
+	if value.IsArray() && typ.Kind() == reflect.Slice && !customType(typ, converters) {
 		array := value.Array()
 		result := reflect.MakeSlice(typ, len(array), len(array))
 		elmType := typ.Elem()
 
 		for i, elm := range array {
-			elmValue, valid := json.convert(elm, elmType)
+			elmValue, valid := json.convert(elm, elmType, converters)
 			if !valid {
 				return nil, false
 			}
@@ -49,7 +81,7 @@ func (json *JSON) GetWithType(name string, typ reflect.Type) (interface{}, bool)
 		return result.Interface(), true
 	}
 
-	return json.convert(value, typ)
+	return json.convert(value, typ, converters)
 }
 
 // GetParams returns nested param
@@ -80,11 +112,27 @@ func (json *JSON) GetParamsSlice(name string) ([]Params, bool) {
 	return nil, false
 }
 
-func (json *JSON) convert(value gjson.Result, typ reflect.Type) (interface{}, bool) {
+func (json *JSON) convert(value gjson.Result, typ reflect.Type, converters Converters) (interface{}, bool) {
 	if value.Type == gjson.Null {
 		return nil, true
 	}
 
+	if convert, ok := lookupConverter(typ, converters); ok {
+		return convert(value.Value())
+	}
+
+	if castable(typ) {
+		return castCustom(typ, value.Value(), []byte(value.Raw))
+	}
+
+	if typ == durationType && value.Type == gjson.String {
+		return convertDuration(value.String())
+	}
+
+	if value.Type == gjson.Number && isNumber(typ.Kind()) {
+		return json.number(value, typ)
+	}
+
 	// handle type alias
 	if typ.PkgPath() != "" && typ.Kind() != reflect.Struct && typ.Kind() != reflect.Slice && typ.Kind() != reflect.Array {
 		rv := reflect.ValueOf(value.Value())
@@ -95,53 +143,45 @@ func (json *JSON) convert(value gjson.Result, typ reflect.Type) (interface{}, bo
 		return rv.Convert(typ).Interface(), true
 	}
 
+	if typ == timeType {
+		return DefaultTimeFormat.Convert(value.Value())
+	}
+
 	switch value.Type {
 	case gjson.False, gjson.True:
 		if typ.Kind() == reflect.Bool {
 			return value.Bool(), true
 		}
-	case gjson.Number:
-		switch typ.Kind() {
-		case reflect.Int:
-			return int(value.Int()), true
-		case reflect.Int8:
-			return int8(value.Int()), true
-		case reflect.Int16:
-			return int16(value.Int()), true
-		case reflect.Int32:
-			return int32(value.Int()), true
-		case reflect.Int64:
-			return value.Int(), true
-		case reflect.Uint:
-			return uint(value.Uint()), true
-		case reflect.Uint8:
-			return uint8(value.Uint()), true
-		case reflect.Uint16:
-			return uint16(value.Uint()), true
-		case reflect.Uint32:
-			return uint32(value.Uint()), true
-		case reflect.Uint64:
-			return value.Uint(), true
-		case reflect.Uintptr:
-			return uintptr(value.Uint()), true
-		case reflect.Float32:
-			return float32(value.Float()), true
-		case reflect.Float64:
-			return value.Float(), true
-		}
 	case gjson.String:
 		if typ.Kind() == reflect.String {
 			return value.String(), true
-		} else if typ == timeType {
-			if res, err := time.Parse(time.RFC3339, value.String()); err == nil {
-				return res, true
-			}
 		}
 	}
 
 	return nil, false
 }
 
+// number converts json number using its raw text, so large integer doesn't lose its precision.
+func (json *JSON) number(value gjson.Result, typ reflect.Type) (interface{}, bool) {
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if i, err := strconv.ParseInt(value.Raw, 10, 64); err == nil {
+			return convertNumber(reflect.ValueOf(i), typ)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if u, err := strconv.ParseUint(value.Raw, 10, 64); err == nil {
+			return convertNumber(reflect.ValueOf(u), typ)
+		}
+	}
+
+	f, err := strconv.ParseFloat(value.Raw, 64)
+	if err != nil {
+		return nil, false
+	}
+
+	return convertNumber(reflect.ValueOf(f), typ)
+}
+
 func (json *JSON) fetch(name string) gjson.Result {
 	if result, ok := json.results.Load(name); ok {
 		return result.(gjson.Result)
@@ -153,6 +193,7 @@ func (json *JSON) fetch(name string) gjson.Result {
 }
 
 // ParseJSON as params
+// It does not restrict the size of params, use Limits.ParseJSON for untrusted input.
 func ParseJSON(json string) Params {
 	return &JSON{Result: gjson.Parse(json)}
 }
@@ -15,6 +15,19 @@ func TestJSON_Exists(t *testing.T) {
 	assert.False(t, p.Exists("not-exists"))
 }
 
+func TestJSON_Presence(t *testing.T) {
+	p := params.ParseJSON(`{"value": "a", "null": null, "nested": {"null": null}}`)
+	assert.Equal(t, params.Present, p.(params.Presencer).Presence("value"))
+	assert.Equal(t, params.Null, p.(params.Presencer).Presence("null"))
+	assert.Equal(t, params.Null, p.(params.Presencer).Presence("nested.null"))
+	assert.Equal(t, params.Absent, p.(params.Presencer).Presence("not-exists"))
+}
+
+func TestJSON_Keys(t *testing.T) {
+	assert.Equal(t, []string{"b", "a", "c"}, params.ParseJSON(`{"b": 1, "a": null, "c": {"d": 1}}`).Keys())
+	assert.Nil(t, params.ParseJSON(`[1, 2]`).Keys())
+}
+
 func TestJSON_Get(t *testing.T) {
 	p := params.ParseJSON(`{"exists": true}`)
 	assert.Equal(t, true, p.Get("exists"))
@@ -0,0 +1,271 @@
+package params
+
+import (
+	"mime/multipart"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+const (
+	// LimitDepth is the name of depth limit.
+	LimitDepth = "depth"
+	// LimitArrayLength is the name of array length limit.
+	LimitArrayLength = "array length"
+	// LimitIndex is the name of array index limit.
+	LimitIndex = "index"
+	// LimitKeys is the name of key count limit.
+	LimitKeys = "keys"
+	// LimitStringLength is the name of string length limit.
+	LimitStringLength = "string length"
+)
+
+// LimitError is returned when params exceeds one of its limits.
+type LimitError struct {
+	// Limit is the name of the exceeded limit, eg: LimitDepth.
+	Limit string
+	Max   int
+	// Key is path of the param that exceeds the limit, empty for LimitKeys.
+	Key string
+}
+
+// Error prints error message.
+func (e LimitError) Error() string {
+	message := "params: " + e.Limit + " exceeds limit of " + strconv.Itoa(e.Max)
+	if e.Key != "" {
+		message += " at " + e.Key
+	}
+
+	return message
+}
+
+// Limits restricts the size of params to protect against abusive payloads, zero value means unlimited.
+// Depth counts nesting level of a param, top level param has depth of 1.
+// Keys counts every object member or form key, including the nested ones.
+type Limits struct {
+	MaxDepth        int
+	MaxArrayLength  int
+	MaxIndex        int
+	MaxKeys         int
+	MaxStringLength int
+}
+
+// DefaultLimits is the limits used by FromRequest.
+var DefaultLimits = Limits{
+	MaxDepth:       32,
+	MaxArrayLength: 10000,
+	MaxIndex:       10000,
+	MaxKeys:        10000,
+}
+
+// ParseJSON parses json as params, returns LimitError if it exceeds the limits.
+func (l Limits) ParseJSON(data string) (Params, error) {
+	keys := 0
+	if err := l.checkJSON(gjson.Parse(data), "", 0, &keys); err != nil {
+		return nil, err
+	}
+
+	return ParseJSON(data), nil
+}
+
+func (l Limits) checkJSON(value gjson.Result, key string, depth int, keys *int) error {
+	if exceeds(depth, l.MaxDepth) {
+		return LimitError{Limit: LimitDepth, Max: l.MaxDepth, Key: key}
+	}
+
+	var err error
+
+	switch {
+	case value.IsObject():
+		value.ForEach(func(k gjson.Result, v gjson.Result) bool {
+			if *keys++; exceeds(*keys, l.MaxKeys) {
+				err = LimitError{Limit: LimitKeys, Max: l.MaxKeys}
+			} else {
+				err = l.checkJSON(v, joinKey(key, k.String()), depth+1, keys)
+			}
+
+			return err == nil
+		})
+	case value.IsArray():
+		index := 0
+		value.ForEach(func(_ gjson.Result, v gjson.Result) bool {
+			if exceeds(index+1, l.MaxArrayLength) {
+				err = LimitError{Limit: LimitArrayLength, Max: l.MaxArrayLength, Key: key}
+			} else {
+				err = l.checkJSON(v, key+"["+strconv.Itoa(index)+"]", depth+1, keys)
+			}
+
+			index++
+			return err == nil
+		})
+	case value.Type == gjson.String:
+		if exceeds(len(value.Str), l.MaxStringLength) {
+			err = LimitError{Limit: LimitStringLength, Max: l.MaxStringLength, Key: key}
+		}
+	}
+
+	return err
+}
+
+// ParseForm parses url values as Form, returns LimitError if it exceeds the limits.
+func (l Limits) ParseForm(raw url.Values) (Form, error) {
+	keys := 0
+	for key, values := range raw {
+		if err := l.checkForm(key, len(values), &keys); err != nil {
+			return nil, err
+		}
+
+		for i := range values {
+			if exceeds(len(values[i]), l.MaxStringLength) {
+				return nil, LimitError{Limit: LimitStringLength, Max: l.MaxStringLength, Key: key}
+			}
+		}
+	}
+
+	return ParseForm(raw), nil
+}
+
+// ParseMultipartForm parses multipart form as Form, returns LimitError if it exceeds the limits.
+// uploaded files are counted the same way as values.
+func (l Limits) ParseMultipartForm(raw *multipart.Form) (Form, error) {
+	if _, err := l.ParseForm(raw.Value); err != nil {
+		return nil, err
+	}
+
+	keys := len(raw.Value)
+	for key, files := range raw.File {
+		if err := l.checkForm(key, len(files), &keys); err != nil {
+			return nil, err
+		}
+	}
+
+	return ParseMultipartForm(raw), nil
+}
+
+func (l Limits) checkForm(key string, count int, keys *int) error {
+	if *keys++; exceeds(*keys, l.MaxKeys) {
+		return LimitError{Limit: LimitKeys, Max: l.MaxKeys}
+	}
+
+	fields := strings.FieldsFunc(key, fieldsExtractor)
+	if exceeds(len(fields), l.MaxDepth) {
+		return LimitError{Limit: LimitDepth, Max: l.MaxDepth, Key: key}
+	}
+
+	for _, field := range fields {
+		if index, err := strconv.Atoi(field); err == nil && (index < 0 || exceeds(index, l.MaxIndex)) {
+			return LimitError{Limit: LimitIndex, Max: l.MaxIndex, Key: key}
+		}
+	}
+
+	if exceeds(count, l.MaxArrayLength) {
+		return LimitError{Limit: LimitArrayLength, Max: l.MaxArrayLength, Key: key}
+	}
+
+	return nil
+}
+
+// Check returns LimitError if params exceeds the limits, used to check params that is not parsed using Limits.
+func (l Limits) Check(p Params) error {
+	keys := 0
+	return l.checkParams(p, "", 1, &keys)
+}
+
+// CheckField returns LimitError if a single field of params exceeds the limits, the field is checked as top level param.
+func (l Limits) CheckField(p Params, name string) error {
+	keys := 0
+	return l.checkField(p, name, name, 1, &keys)
+}
+
+func (l Limits) checkParams(p Params, prefix string, depth int, keys *int) error {
+	for _, name := range p.Keys() {
+		if err := l.checkField(p, name, joinKey(prefix, name), depth, keys); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l Limits) checkField(p Params, name string, key string, depth int, keys *int) error {
+	if *keys++; exceeds(*keys, l.MaxKeys) {
+		return LimitError{Limit: LimitKeys, Max: l.MaxKeys}
+	}
+
+	if exceeds(depth, l.MaxDepth) {
+		return LimitError{Limit: LimitDepth, Max: l.MaxDepth, Key: key}
+	}
+
+	// nested params is checked before slice of nested params, since form with a single nested params satisfies both.
+	if par, ok := p.GetParams(name); ok {
+		return l.checkParams(par, key, depth+1, keys)
+	}
+
+	if pars, ok := p.GetParamsSlice(name); ok {
+		if exceeds(len(pars), l.MaxArrayLength) {
+			return LimitError{Limit: LimitArrayLength, Max: l.MaxArrayLength, Key: key}
+		}
+
+		if len(pars) > 0 && exceeds(depth+1, l.MaxDepth) {
+			return LimitError{Limit: LimitDepth, Max: l.MaxDepth, Key: key + "[0]"}
+		}
+
+		for i := range pars {
+			if err := l.checkParams(pars[i], key+"["+strconv.Itoa(i)+"]", depth+2, keys); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return l.checkValue(reflect.ValueOf(p.Get(name)), key, depth)
+}
+
+func (l Limits) checkValue(rv reflect.Value, key string, depth int) error {
+	if exceeds(depth, l.MaxDepth) {
+		return LimitError{Limit: LimitDepth, Max: l.MaxDepth, Key: key}
+	}
+
+	switch rv.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if !rv.IsNil() {
+			return l.checkValue(rv.Elem(), key, depth)
+		}
+	case reflect.String:
+		if exceeds(rv.Len(), l.MaxStringLength) {
+			return LimitError{Limit: LimitStringLength, Max: l.MaxStringLength, Key: key}
+		}
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return nil
+		}
+
+		if exceeds(rv.Len(), l.MaxArrayLength) {
+			return LimitError{Limit: LimitArrayLength, Max: l.MaxArrayLength, Key: key}
+		}
+
+		for i := 0; i < rv.Len(); i++ {
+			if err := l.checkValue(rv.Index(i), key, depth); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func exceeds(value int, max int) bool {
+	return max > 0 && value > max
+}
+
+func joinKey(prefix string, key string) string {
+	if prefix == "" {
+		return key
+	}
+
+	return prefix + "." + key
+}
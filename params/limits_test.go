@@ -0,0 +1,125 @@
+package params_test
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/go-rel/changeset/params"
+	"github.com/stretchr/testify/assert"
+)
+
+var testLimits = params.Limits{
+	MaxDepth:        3,
+	MaxArrayLength:  2,
+	MaxIndex:        5,
+	MaxKeys:         5,
+	MaxStringLength: 5,
+}
+
+func TestLimitError(t *testing.T) {
+	assert.Equal(t, "params: depth exceeds limit of 3 at a.b.c.d", params.LimitError{Limit: params.LimitDepth, Max: 3, Key: "a.b.c.d"}.Error())
+	assert.Equal(t, "params: keys exceeds limit of 5", params.LimitError{Limit: params.LimitKeys, Max: 5}.Error())
+}
+
+func TestLimits_ParseJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		err  error
+	}{
+		{"valid", `{"a": {"b": [1, "short"]}, "c": null}`, nil},
+		{"depth", `{"a": {"b": {"c": {"d": 1}}}}`, params.LimitError{Limit: params.LimitDepth, Max: 3, Key: "a.b.c.d"}},
+		{"depth array", `{"a": [[[1]]]}`, params.LimitError{Limit: params.LimitDepth, Max: 3, Key: "a[0][0][0]"}},
+		{"array length", `{"a": [1, 2, 3]}`, params.LimitError{Limit: params.LimitArrayLength, Max: 2, Key: "a"}},
+		{"keys", `{"a": 1, "b": 2, "c": {"d": 3, "e": 4, "f": 5}}`, params.LimitError{Limit: params.LimitKeys, Max: 5}},
+		{"string length", `{"a": [{"b": "too long"}]}`, params.LimitError{Limit: params.LimitStringLength, Max: 5, Key: "a[0].b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := testLimits.ParseJSON(tt.data)
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.err == nil, p != nil)
+		})
+	}
+}
+
+func TestLimits_ParseForm(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  url.Values
+		err  error
+	}{
+		{"valid", url.Values{"a[b][0]": {"1"}, "c": {"x", "y"}}, nil},
+		{"depth", url.Values{"a[b][c][d]": {"1"}}, params.LimitError{Limit: params.LimitDepth, Max: 3, Key: "a[b][c][d]"}},
+		{"index", url.Values{"items[999999999][name]": {"1"}}, params.LimitError{Limit: params.LimitIndex, Max: 5, Key: "items[999999999][name]"}},
+		{"index overflow", url.Values{"items[99999999999999999999]": {"1"}}, nil},
+		{"array length", url.Values{"a": {"1", "2", "3"}}, params.LimitError{Limit: params.LimitArrayLength, Max: 2, Key: "a"}},
+		{"keys", url.Values{"a": {"1"}, "b": {"1"}, "c": {"1"}, "d": {"1"}, "e": {"1"}, "f": {"1"}}, params.LimitError{Limit: params.LimitKeys, Max: 5}},
+		{"string length", url.Values{"a": {"too long"}}, params.LimitError{Limit: params.LimitStringLength, Max: 5, Key: "a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := testLimits.ParseForm(tt.raw)
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.err == nil, p != nil)
+		})
+	}
+}
+
+func TestLimits_ParseMultipartForm(t *testing.T) {
+	form := newMultipartForm(t, map[string]string{"name": "Luffy"}, map[string][]string{"photos": {"a", "b", "c"}})
+
+	_, err := testLimits.ParseMultipartForm(form)
+	assert.Equal(t, params.LimitError{Limit: params.LimitArrayLength, Max: 2, Key: "photos"}, err)
+
+	p, err := params.Limits{MaxArrayLength: 3}.ParseMultipartForm(form)
+	assert.Nil(t, err)
+	assert.True(t, p.Exists("photos"))
+
+	_, err = params.Limits{MaxStringLength: 3}.ParseMultipartForm(form)
+	assert.Equal(t, params.LimitError{Limit: params.LimitStringLength, Max: 3, Key: "name"}, err)
+}
+
+func TestLimits_Check(t *testing.T) {
+	tests := []struct {
+		name string
+		p    params.Params
+		err  error
+	}{
+		{"valid", params.Map{"a": []params.Map{{"c": 1}}}, nil},
+		{"depth", params.Map{"a": params.Map{"b": params.Map{"c": params.Map{"d": 1}}}}, params.LimitError{Limit: params.LimitDepth, Max: 3, Key: "a.b.c.d"}},
+		{"depth slice", params.Map{"a": params.Map{"b": []params.Map{{"c": 1}}}}, params.LimitError{Limit: params.LimitDepth, Max: 3, Key: "a.b[0].c"}},
+		{"depth slice exceeded", params.Map{"a": params.Map{"b": params.Map{"c": []params.Map{{}}}}}, params.LimitError{Limit: params.LimitDepth, Max: 3, Key: "a.b.c[0]"}},
+		{"array length", params.Map{"a": []params.Map{{}, {}, {}}}, params.LimitError{Limit: params.LimitArrayLength, Max: 2, Key: "a"}},
+		{"array length value", params.Map{"a": []int{1, 2, 3}}, params.LimitError{Limit: params.LimitArrayLength, Max: 2, Key: "a"}},
+		{"keys", params.Map{"a": []params.Map{{"b": 1, "c": 2}, {"d": 3, "e": 4, "f": 5}}}, params.LimitError{Limit: params.LimitKeys, Max: 5}},
+		{"string length", params.ParseForm(url.Values{"a[b]": {"too long"}}), params.LimitError{Limit: params.LimitStringLength, Max: 5, Key: "a.b"}},
+		{"string length pointer", params.Map{"a": &[]string{"too long"}[0]}, params.LimitError{Limit: params.LimitStringLength, Max: 5, Key: "a"}},
+		{"bytes", params.Map{"a": []byte(strings.Repeat("a", 10))}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.err, testLimits.Check(tt.p))
+		})
+	}
+}
+
+func TestLimits_CheckField(t *testing.T) {
+	p := params.Map{
+		"a": params.Map{"b": params.Map{"c": 1}},
+		"d": params.Map{"e": params.Map{"f": params.Map{"g": 1}}},
+	}
+
+	assert.Nil(t, testLimits.CheckField(p, "a"))
+	assert.Equal(t, params.LimitError{Limit: params.LimitDepth, Max: 3, Key: "d.e.f.g"}, testLimits.CheckField(p, "d"))
+}
+
+func TestLimits_unlimited(t *testing.T) {
+	_, err := params.Limits{}.ParseForm(url.Values{"items[999999]": {"1"}})
+	assert.Nil(t, err)
+	assert.Nil(t, params.Limits{}.Check(params.ParseJSON(`{"a": {"b": {"c": {"d": [1, 2, 3]}}}}`)))
+}
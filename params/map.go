@@ -2,6 +2,7 @@ package params
 
 import (
 	"reflect"
+	"sort"
 )
 
 // Map is param type alias for map[string]interface{}
@@ -15,6 +16,36 @@ func (m Map) Exists(name string) bool {
 	return exists
 }
 
+// Presence returns whether key is absent, explicitly null or has a value.
+// nil pointer value is considered as null.
+func (m Map) Presence(name string) Presence {
+	value, exists := m[name]
+	if !exists {
+		return Absent
+	}
+
+	if value == nil {
+		return Null
+	}
+
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return Null
+	}
+
+	return Present
+}
+
+// Keys returns sorted keys of the map.
+func (m Map) Keys() []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
 // Get returns value as interface.
 // returns nil if value doens't exists.
 func (m Map) Get(name string) interface{} {
@@ -26,6 +57,11 @@ func (m Map) Get(name string) interface{} {
 // If value is not convertible to type, it'll return nil, false
 // If value is not exists, it will return nil, true
 func (m Map) GetWithType(name string, typ reflect.Type) (interface{}, bool) {
+	return m.GetWithConverters(name, typ, nil)
+}
+
+// GetWithConverters returns value given from given name and type, converted using converters when available.
+func (m Map) GetWithConverters(name string, typ reflect.Type, converters Converters) (interface{}, bool) {
 	value := m[name]
 
 	if value == nil {
@@ -44,6 +80,18 @@ func (m Map) GetWithType(name string, typ reflect.Type) (interface{}, bool) {
 		return nil, true
 	}
 
+	if convert, ok := lookupConverter(typ, converters); ok {
+		return convert(rv.Interface())
+	}
+
+	if castableFrom(rt, typ) {
+		return castCustom(typ, rv.Interface(), nil)
+	}
+
+	if typ == timeType && !rt.ConvertibleTo(typ) {
+		return DefaultTimeFormat.Convert(rv.Interface())
+	}
+
 	if typ.Kind() == reflect.Slice && (rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array) {
 		result := reflect.MakeSlice(typ, rv.Len(), rv.Len())
 		elemTyp := typ.Elem()
@@ -54,8 +102,15 @@ func (m Map) GetWithType(name string, typ reflect.Type) (interface{}, bool) {
 				elem = elem.Elem()
 			}
 
-			if elem.Type().ConvertibleTo(elemTyp) {
-				result.Index(i).Set(elem.Convert(elemTyp))
+			if customElem(elem.Type(), elemTyp, converters) {
+				elemValue, valid := m.convert(elem.Interface(), elemTyp, converters)
+				if !valid {
+					return nil, false
+				}
+
+				result.Index(i).Set(reflect.ValueOf(elemValue))
+			} else if elemValue, valid := m.convertValue(elem, elemTyp); valid {
+				result.Index(i).Set(reflect.ValueOf(elemValue))
 			} else {
 				return nil, false
 			}
@@ -64,6 +119,21 @@ func (m Map) GetWithType(name string, typ reflect.Type) (interface{}, bool) {
 		return result.Interface(), true
 	}
 
+	return m.convertValue(rv, typ)
+}
+
+// convertValue converts value using reflection, number is checked for overflow and duration is parsed from string.
+func (m Map) convertValue(rv reflect.Value, typ reflect.Type) (interface{}, bool) {
+	rt := rv.Type()
+
+	if isNumber(rt.Kind()) && isNumber(typ.Kind()) {
+		return convertNumber(rv, typ)
+	}
+
+	if typ == durationType && rt.Kind() == reflect.String {
+		return convertDuration(rv.String())
+	}
+
 	if !rt.ConvertibleTo(typ) {
 		return nil, false
 	}
@@ -71,6 +141,23 @@ func (m Map) GetWithType(name string, typ reflect.Type) (interface{}, bool) {
 	return rv.Convert(typ).Interface(), true
 }
 
+// customElem returns true if slice element needs to be converted using converter or Caster.
+func customElem(rt reflect.Type, typ reflect.Type, converters Converters) bool {
+	if _, ok := lookupConverter(typ, converters); ok {
+		return true
+	}
+
+	return castableFrom(rt, typ)
+}
+
+func (m Map) convert(value interface{}, typ reflect.Type, converters Converters) (interface{}, bool) {
+	if convert, ok := lookupConverter(typ, converters); ok {
+		return convert(value)
+	}
+
+	return castCustom(typ, value, nil)
+}
+
 // GetParams returns nested param
 func (m Map) GetParams(name string) (Params, bool) {
 	if val, exist := m[name]; exist {
@@ -15,6 +15,21 @@ func TestMap_Exists(t *testing.T) {
 	assert.False(t, p.Exists("not-exists"))
 }
 
+func TestMap_Presence(t *testing.T) {
+	var nilPtr *string
+	p := params.Map{"value": "a", "null": nil, "nil_ptr": nilPtr}
+	assert.Equal(t, params.Present, p.Presence("value"))
+	assert.Equal(t, params.Null, p.Presence("null"))
+	assert.Equal(t, params.Null, p.Presence("nil_ptr"))
+	assert.Equal(t, params.Absent, p.Presence("not-exists"))
+}
+
+func TestMap_Keys(t *testing.T) {
+	p := params.Map{"b": 1, "a": nil, "c": params.Map{"d": 1}}
+	assert.Equal(t, []string{"a", "b", "c"}, p.Keys())
+	assert.Equal(t, []string{}, params.Map{}.Keys())
+}
+
 func TestMap_Get(t *testing.T) {
 	p := params.Map{"exists": true}
 	assert.Equal(t, true, p.Get("exists"))
@@ -0,0 +1,230 @@
+package params
+
+import (
+	"reflect"
+	"sort"
+)
+
+// Sourcer is implemented by params that know which source a field comes from, eg: query or body.
+type Sourcer interface {
+	Source(name string) string
+}
+
+// NamedParams is params that is labeled with the name of its source.
+// nested params are labeled using the same name.
+type NamedParams struct {
+	Params
+	Name string
+}
+
+var (
+	_ Params     = NamedParams{}
+	_ Presencer  = NamedParams{}
+	_ Sourcer    = NamedParams{}
+	_ Positioner = NamedParams{}
+)
+
+// Named labels params with the name of its source, used by Source to attribute a field.
+// The name is available as source argument of cast errors, default messages don't include it,
+// use Message option or translation with {source} placeholder to show it.
+func Named(name string, p Params) NamedParams {
+	return NamedParams{Params: p, Name: name}
+}
+
+// Source returns name of the params if field exists.
+func (n NamedParams) Source(name string) string {
+	if !n.Exists(name) {
+		return ""
+	}
+
+	return n.Name
+}
+
+// Presence returns presence of key in the labeled params.
+func (n NamedParams) Presence(name string) Presence {
+	return presence(n.Params, name)
+}
+
+// Position returns location of the field when the labeled params know it, eg: CSVRow.
+func (n NamedParams) Position(field string) (int, int, bool) {
+	if p, ok := n.Params.(Positioner); ok {
+		return p.Position(field)
+	}
+
+	return 0, 0, false
+}
+
+// GetWithConverters returns value given from given name and type, converted using converters when available.
+func (n NamedParams) GetWithConverters(name string, typ reflect.Type, converters Converters) (interface{}, bool) {
+	return GetWithConverters(n.Params, name, typ, converters)
+}
+
+// GetParams returns nested param labeled with the same name.
+func (n NamedParams) GetParams(name string) (Params, bool) {
+	par, ok := n.Params.GetParams(name)
+	if !ok {
+		return nil, false
+	}
+
+	return Named(n.Name, par), true
+}
+
+// GetParamsSlice returns slice of nested param labeled with the same name.
+func (n NamedParams) GetParamsSlice(name string) ([]Params, bool) {
+	pars, ok := n.Params.GetParamsSlice(name)
+	if !ok {
+		return nil, false
+	}
+
+	named := make([]Params, len(pars))
+	for i := range pars {
+		named[i] = Named(n.Name, pars[i])
+	}
+
+	return named, true
+}
+
+// Merged is params merged from multiple sources.
+type Merged struct {
+	// sources ordered from the highest precedence.
+	sources []Params
+}
+
+var (
+	_ Params    = Merged{}
+	_ Presencer = Merged{}
+	_ Sourcer   = Merged{}
+)
+
+// Merge params from multiple sources, sources listed later take precedence over the earlier ones,
+// eg: Merge(defaults, Named("query parameter", query), Named("body field", body)).
+// Nested params is merged across sources, while slice of nested params is taken from a single source as a whole.
+func Merge(sources ...Params) Merged {
+	merged := Merged{sources: make([]Params, len(sources))}
+	for i := range sources {
+		merged.sources[len(sources)-1-i] = sources[i]
+	}
+
+	return merged
+}
+
+// lookup returns source with the highest precedence that contains the field.
+func (m Merged) lookup(name string) (Params, bool) {
+	for _, source := range m.sources {
+		if source.Exists(name) {
+			return source, true
+		}
+	}
+
+	return nil, false
+}
+
+// Exists returns true if key exists in any of the sources.
+func (m Merged) Exists(name string) bool {
+	_, exists := m.lookup(name)
+	return exists
+}
+
+// Presence returns presence of key from source with the highest precedence that contains the key.
+func (m Merged) Presence(name string) Presence {
+	source, exists := m.lookup(name)
+	if !exists {
+		return Absent
+	}
+
+	return presence(source, name)
+}
+
+// Keys returns sorted keys of all sources.
+func (m Merged) Keys() []string {
+	var (
+		keys = []string{}
+		seen = make(map[string]bool)
+	)
+
+	for _, source := range m.sources {
+		for _, key := range source.Keys() {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// Source returns name of the source that provides the field, returns empty string if the source is not named.
+func (m Merged) Source(name string) string {
+	if source, exists := m.lookup(name); exists {
+		if s, ok := source.(Sourcer); ok {
+			return s.Source(name)
+		}
+	}
+
+	return ""
+}
+
+// Get returns value as interface.
+// returns nil if value doens't exists.
+func (m Merged) Get(name string) interface{} {
+	if source, exists := m.lookup(name); exists {
+		return source.Get(name)
+	}
+
+	return nil
+}
+
+// GetWithType returns value given from given name and type.
+// second return value will only be false if the type of parameter is not convertible to requested type.
+// If value is not convertible to type, it'll return nil, false
+// If value is not exists, it will return nil, true
+func (m Merged) GetWithType(name string, typ reflect.Type) (interface{}, bool) {
+	return m.GetWithConverters(name, typ, nil)
+}
+
+// GetWithConverters returns value given from given name and type, converted using converters when available.
+func (m Merged) GetWithConverters(name string, typ reflect.Type, converters Converters) (interface{}, bool) {
+	if source, exists := m.lookup(name); exists {
+		return GetWithConverters(source, name, typ, converters)
+	}
+
+	return nil, true
+}
+
+// GetParams returns nested param merged from sources, source that contains non params value shadows the rest.
+func (m Merged) GetParams(name string) (Params, bool) {
+	var nested Merged
+
+	for _, source := range m.sources {
+		if !source.Exists(name) {
+			continue
+		}
+
+		par, ok := source.GetParams(name)
+		if !ok {
+			break
+		}
+
+		nested.sources = append(nested.sources, par)
+	}
+
+	switch len(nested.sources) {
+	case 0:
+		return nil, false
+	case 1:
+		return nested.sources[0], true
+	}
+
+	return nested, true
+}
+
+// GetParamsSlice returns slice of nested param from source with the highest precedence that contains the field.
+func (m Merged) GetParamsSlice(name string) ([]Params, bool) {
+	if source, exists := m.lookup(name); exists {
+		return source.GetParamsSlice(name)
+	}
+
+	return nil, false
+}
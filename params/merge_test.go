@@ -0,0 +1,141 @@
+package params_test
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-rel/changeset/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge(t *testing.T) {
+	var (
+		defaults = params.Map{
+			"limit":  10,
+			"sort":   "id",
+			"filter": params.Map{"status": "active", "role": "admin"},
+			"items":  []params.Map{{"name": "default"}},
+		}
+		query = params.Named("query parameter", params.ParseForm(url.Values{
+			"limit":          {"20"},
+			"filter[role]":   {"member"},
+			"items[0][name]": {"query"},
+		}))
+		body = params.Named("body field", params.ParseJSON(`{
+			"limit": null,
+			"name": "Luffy",
+			"filter": {"age": 19}
+		}`))
+		p = params.Merge(defaults, query, body)
+	)
+
+	assert.Equal(t, []string{"filter", "items", "limit", "name", "sort"}, p.Keys())
+	assert.True(t, p.Exists("sort"))
+	assert.False(t, p.Exists("not-exists"))
+	assert.Equal(t, params.Null, p.Presence("limit"))
+	assert.Equal(t, params.Null, body.Presence("limit"))
+	assert.Equal(t, params.Absent, p.Presence("not-exists"))
+	assert.Equal(t, "id", p.Get("sort"))
+	assert.Nil(t, p.Get("not-exists"))
+
+	assert.Equal(t, "body field", p.Source("limit"))
+	assert.Equal(t, "body field", p.Source("name"))
+	assert.Equal(t, "", p.Source("sort"))
+	assert.Equal(t, "", p.Source("not-exists"))
+
+	limit, valid := p.GetWithType("limit", reflect.TypeOf(0))
+	assert.True(t, valid)
+	assert.Nil(t, limit)
+
+	name, valid := p.GetWithType("name", reflect.TypeOf(""))
+	assert.True(t, valid)
+	assert.Equal(t, "Luffy", name)
+
+	value, valid := p.GetWithType("not-exists", reflect.TypeOf(""))
+	assert.True(t, valid)
+	assert.Nil(t, value)
+
+	filter, valid := p.GetParams("filter")
+	assert.True(t, valid)
+	assert.Equal(t, []string{"age", "role", "status"}, filter.Keys())
+	assert.Equal(t, "query parameter", filter.(params.Sourcer).Source("role"))
+	assert.Equal(t, "body field", filter.(params.Sourcer).Source("age"))
+
+	role, valid := filter.GetWithType("role", reflect.TypeOf(""))
+	assert.True(t, valid)
+	assert.Equal(t, "member", role)
+
+	status, valid := filter.GetWithType("status", reflect.TypeOf(""))
+	assert.True(t, valid)
+	assert.Equal(t, "active", status)
+
+	items, valid := p.GetParamsSlice("items")
+	assert.True(t, valid)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "query parameter", items[0].(params.Sourcer).Source("name"))
+
+	_, valid = p.GetParams("name")
+	assert.False(t, valid)
+
+	_, valid = p.GetParamsSlice("not-exists")
+	assert.False(t, valid)
+}
+
+func TestMerge_shadowed(t *testing.T) {
+	p := params.Merge(
+		params.Map{"filter": params.Map{"status": "active"}},
+		params.Map{"filter": "none"},
+	)
+
+	_, valid := p.GetParams("filter")
+	assert.False(t, valid)
+
+	p = params.Merge(
+		params.Map{"filter": "none"},
+		params.Map{"filter": params.Map{"status": "active"}},
+	)
+
+	filter, valid := p.GetParams("filter")
+	assert.True(t, valid)
+	assert.Equal(t, params.Map{"status": "active"}, filter)
+}
+
+func TestNamed(t *testing.T) {
+	p := params.Named("body field", params.Map{
+		"name":    "Luffy",
+		"address": params.Map{"city": "East Blue"},
+		"items":   []params.Map{{"name": "hat"}},
+	})
+
+	assert.Equal(t, "body field", p.Source("name"))
+	assert.Equal(t, "", p.Source("not-exists"))
+
+	address, valid := p.GetParams("address")
+	assert.True(t, valid)
+	assert.Equal(t, "body field", address.(params.Sourcer).Source("city"))
+
+	items, valid := p.GetParamsSlice("items")
+	assert.True(t, valid)
+	assert.Equal(t, "body field", items[0].(params.Sourcer).Source("name"))
+
+	_, valid = p.GetParams("name")
+	assert.False(t, valid)
+
+	_, valid = p.GetParamsSlice("name")
+	assert.False(t, valid)
+}
+
+func TestNamed_position(t *testing.T) {
+	row, err := params.NewCSVReader(strings.NewReader("name,age\nLuffy,19\n")).Read()
+	assert.Nil(t, err)
+
+	line, column, ok := params.Named("csv", row).Position("age")
+	assert.True(t, ok)
+	assert.Equal(t, 2, line)
+	assert.Equal(t, 7, column)
+
+	_, _, ok = params.Named("body field", params.Map{"age": 19}).Position("age")
+	assert.False(t, ok)
+}
@@ -0,0 +1,124 @@
+package params
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func isNumber(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+// convertNumber converts numeric value to numeric type.
+// returns false if the value overflows the type, or if fractional value is converted to integer.
+func convertNumber(rv reflect.Value, typ reflect.Type) (interface{}, bool) {
+	result := reflect.New(typ).Elem()
+
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var v int64
+
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			v = rv.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if rv.Uint() > math.MaxInt64 {
+				return nil, false
+			}
+
+			v = int64(rv.Uint())
+		default:
+			f := rv.Float()
+			if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+				return nil, false
+			}
+
+			v = int64(f)
+		}
+
+		if result.OverflowInt(v) {
+			return nil, false
+		}
+
+		result.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var v uint64
+
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if rv.Int() < 0 {
+				return nil, false
+			}
+
+			v = uint64(rv.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			v = rv.Uint()
+		default:
+			f := rv.Float()
+			if f != math.Trunc(f) || f < 0 || f >= math.MaxUint64 {
+				return nil, false
+			}
+
+			v = uint64(f)
+		}
+
+		if result.OverflowUint(v) {
+			return nil, false
+		}
+
+		result.SetUint(v)
+	default:
+		var v float64
+
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			v = float64(rv.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			v = float64(rv.Uint())
+		default:
+			v = rv.Float()
+		}
+
+		if result.OverflowFloat(v) {
+			return nil, false
+		}
+
+		result.SetFloat(v)
+	}
+
+	return result.Interface(), true
+}
+
+// convertDuration parses duration string such as "1h30m".
+func convertDuration(str string) (interface{}, bool) {
+	d, err := time.ParseDuration(str)
+	if err != nil {
+		return nil, false
+	}
+
+	return d, true
+}
+
+// numberText returns text of numeric value, raw json is used as is to keep its precision.
+func numberText(raw interface{}, rawJSON []byte) (string, bool) {
+	if len(rawJSON) > 0 && (rawJSON[0] == '-' || (rawJSON[0] >= '0' && rawJSON[0] <= '9')) {
+		return string(rawJSON), true
+	}
+
+	if raw != nil && isNumber(reflect.TypeOf(raw).Kind()) {
+		return fmt.Sprint(raw), true
+	}
+
+	return "", false
+}
@@ -0,0 +1,119 @@
+package params_test
+
+import (
+	"math/big"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-rel/changeset/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func bigInt(s string) big.Int {
+	var i big.Int
+	i.SetString(s, 10)
+	return i
+}
+
+func bigFloat(s string) big.Float {
+	var f big.Float
+	f.UnmarshalText([]byte(s))
+	return f
+}
+
+func bigRat(s string) big.Rat {
+	var r big.Rat
+	r.SetString(s)
+	return r
+}
+
+func TestParams_GetWithType_number(t *testing.T) {
+	var (
+		p = map[string]params.Params{
+			"map": params.Map{
+				"int8":        127,
+				"int8 over":   128,
+				"uint neg":    -1,
+				"fraction":    1.5,
+				"whole float": float64(2),
+				"large":       int64(9007199254740993),
+				"uint64 max":  uint64(18446744073709551615),
+				"float32 max": 1e39,
+				"duration":    "1h30m",
+				"duration ns": 90,
+				"big int":     "123456789012345678901234567890",
+				"big float":   1.5,
+				"big rat":     "3/4",
+				"slice over":  []interface{}{1, 300},
+			},
+			"form": params.ParseForm(url.Values{
+				"int8":        {"127"},
+				"int8 over":   {"128"},
+				"uint neg":    {"-1"},
+				"fraction":    {"1.5"},
+				"whole float": {"2"},
+				"large":       {"9007199254740993"},
+				"uint64 max":  {"18446744073709551615"},
+				"float32 max": {"1e39"},
+				"duration":    {"1h30m"},
+				"duration ns": {"90"},
+				"big int":     {"123456789012345678901234567890"},
+				"big float":   {"1.5"},
+				"big rat":     {"3/4"},
+				"slice over":  {"1", "300"},
+			}),
+			"json": params.ParseJSON(`{
+				"int8": 127,
+				"int8 over": 128,
+				"uint neg": -1,
+				"fraction": 1.5,
+				"whole float": 2.0,
+				"large": 9007199254740993,
+				"uint64 max": 18446744073709551615,
+				"float32 max": 1e39,
+				"duration": "1h30m",
+				"duration ns": 90,
+				"big int": 123456789012345678901234567890,
+				"big float": 1.5,
+				"big rat": "3/4",
+				"slice over": [1, 300]
+			}`),
+		}
+		tests = []struct {
+			field    string
+			typ      reflect.Type
+			expected interface{}
+			valid    bool
+		}{
+			{"int8", reflect.TypeOf(int8(0)), int8(127), true},
+			{"int8 over", reflect.TypeOf(int8(0)), nil, false},
+			{"int8 over", reflect.TypeOf(Number(0)), Number(128), true},
+			{"uint neg", reflect.TypeOf(uint(0)), nil, false},
+			{"fraction", reflect.TypeOf(0), nil, false},
+			{"whole float", reflect.TypeOf(0), 2, true},
+			{"large", reflect.TypeOf(int64(0)), int64(9007199254740993), true},
+			{"uint64 max", reflect.TypeOf(uint64(0)), uint64(18446744073709551615), true},
+			{"uint64 max", reflect.TypeOf(int64(0)), nil, false},
+			{"float32 max", reflect.TypeOf(float32(0)), nil, false},
+			{"float32 max", reflect.TypeOf(float64(0)), 1e39, true},
+			{"duration", reflect.TypeOf(time.Duration(0)), 90 * time.Minute, true},
+			{"duration ns", reflect.TypeOf(time.Duration(0)), time.Duration(90), true},
+			{"big int", reflect.TypeOf(big.Int{}), bigInt("123456789012345678901234567890"), true},
+			{"big float", reflect.TypeOf(big.Float{}), bigFloat("1.5"), true},
+			{"big rat", reflect.TypeOf(big.Rat{}), bigRat("3/4"), true},
+			{"slice over", reflect.TypeOf([]int8{}), nil, false},
+		}
+	)
+
+	for name, p := range p {
+		for _, tt := range tests {
+			t.Run(name+" "+tt.field+" "+tt.typ.String(), func(t *testing.T) {
+				result, valid := p.GetWithType(tt.field, tt.typ)
+				assert.Equal(t, tt.valid, valid)
+				assert.Equal(t, tt.expected, result)
+			})
+		}
+	}
+}
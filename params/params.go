@@ -8,11 +8,42 @@ import (
 
 var timeType = reflect.TypeOf(time.Time{})
 
+// Presence of a param, used to distinguish absent param from explicit null.
+type Presence int
+
+const (
+	// Absent param is not sent at all.
+	Absent Presence = iota
+	// Null param is sent explicitly as null.
+	Null
+	// Present param is sent with a value.
+	Present
+)
+
+// Presencer is implemented by params that can distinguish absent param from explicit null.
+type Presencer interface {
+	Presence(name string) Presence
+}
+
 // Params is interface used by changeset when casting parameters to changeset.
 type Params interface {
 	Exists(name string) bool
+	Keys() []string
 	Get(name string) interface{}
 	GetWithType(name string, typ reflect.Type) (interface{}, bool)
 	GetParams(name string) (Params, bool)
 	GetParamsSlice(name string) ([]Params, bool)
 }
+
+// presence returns presence of key using Presencer, params that can't tell explicit null only reports whether key exists.
+func presence(p Params, name string) Presence {
+	if pr, ok := p.(Presencer); ok {
+		return pr.Presence(name)
+	}
+
+	if p.Exists(name) {
+		return Present
+	}
+
+	return Absent
+}
@@ -0,0 +1,428 @@
+package params
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrMalformedPatch is returned when patch document can't be decoded.
+	ErrMalformedPatch = errors.New("params: malformed patch")
+	// ErrPatchOperation is returned when json patch operation is unknown or missing its members.
+	ErrPatchOperation = errors.New("params: invalid patch operation")
+	// ErrPatchPath is returned when json patch path doesn't exist or is invalid.
+	ErrPatchPath = errors.New("params: invalid patch path")
+	// ErrPatchTest is returned when json patch test operation fails.
+	ErrPatchTest = errors.New("params: patch test failed")
+)
+
+// PatchError is returned when a json patch operation can't be applied, Path is the json pointer of the operation.
+type PatchError struct {
+	Op   string
+	Path string
+	Err  error
+}
+
+// Error prints error message.
+func (e PatchError) Error() string {
+	return e.Err.Error() + ": " + e.Op + " " + e.Path
+}
+
+// Unwrap internal error.
+func (e PatchError) Unwrap() error {
+	return e.Err
+}
+
+// ParseMergePatch parses JSON Merge Patch (RFC 7396) document as params.
+// null member removes the field and is casted as explicit null, while nested object is merged into existing value.
+func ParseMergePatch(data []byte) (Params, error) {
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(data, &doc); err != nil || doc == nil {
+		return nil, ErrMalformedPatch
+	}
+
+	return ParseJSON(string(data)), nil
+}
+
+// ApplyJSONPatch applies JSON Patch (RFC 6902) document to doc, and returns the result as params.
+// doc must be encodable as json object. Returned params only contains fields affected by the patch,
+// object is narrowed to the affected members so it can be merged into existing value, while array is returned as a whole.
+// Removed field is returned as explicit null. Patch is applied atomically, error is returned if any operation fails.
+func ApplyJSONPatch(doc interface{}, patch []byte) (Params, error) {
+	var operations []map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &operations); err != nil || operations == nil {
+		return nil, ErrMalformedPatch
+	}
+
+	root, err := decodeJSON(doc)
+	if _, ok := root.(map[string]interface{}); err != nil || !ok {
+		return nil, ErrMalformedPatch
+	}
+
+	var touched [][]string
+
+	for _, operation := range operations {
+		var (
+			op, path string
+			opErr    = json.Unmarshal(operation["op"], &op)
+			pathErr  = json.Unmarshal(operation["path"], &path)
+		)
+
+		if opErr != nil || pathErr != nil {
+			return nil, PatchError{Op: op, Path: path, Err: ErrPatchOperation}
+		}
+
+		tokens, ok := ParsePointer(path)
+		if !ok || (len(tokens) == 0 && op != "test") {
+			return nil, PatchError{Op: op, Path: path, Err: ErrPatchPath}
+		}
+
+		if root, err = applyPatchOperation(root, op, tokens, operation); err != nil {
+			if !errors.Is(err, ErrPatchOperation) && !errors.Is(err, ErrPatchTest) {
+				err = ErrPatchPath
+			}
+
+			return nil, PatchError{Op: op, Path: path, Err: err}
+		}
+
+		if op == "move" {
+			var from string
+			json.Unmarshal(operation["from"], &from)
+			fromTokens, _ := ParsePointer(from)
+			touched = append(touched, fromTokens)
+		}
+
+		if op != "test" {
+			touched = append(touched, tokens)
+		}
+	}
+
+	result := make(map[string]interface{})
+	for _, tokens := range touched {
+		projectPatch(root.(map[string]interface{}), result, tokens)
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseJSON(string(data)), nil
+}
+
+func applyPatchOperation(root interface{}, op string, path []string, operation map[string]json.RawMessage) (interface{}, error) {
+	switch op {
+	case "add", "replace", "test":
+		raw, ok := operation["value"]
+		if !ok {
+			return nil, ErrPatchOperation
+		}
+
+		value, err := decodeJSON(raw)
+		if err != nil {
+			return nil, ErrPatchOperation
+		}
+
+		switch op {
+		case "add":
+			return updateNode(root, path, addNode(value))
+		case "replace":
+			return updateNode(root, path, replaceNode(value))
+		}
+
+		current, err := getNode(root, path)
+		if err != nil {
+			return nil, err
+		}
+
+		if !jsonEqual(current, value) {
+			return nil, ErrPatchTest
+		}
+
+		return root, nil
+	case "remove":
+		return updateNode(root, path, removeNode)
+	case "move", "copy":
+		var from string
+		if json.Unmarshal(operation["from"], &from) != nil {
+			return nil, ErrPatchOperation
+		}
+
+		fromPath, ok := ParsePointer(from)
+		if !ok || len(fromPath) == 0 {
+			return nil, ErrPatchPath
+		}
+
+		value, err := getNode(root, fromPath)
+		if err != nil {
+			return nil, err
+		}
+
+		if op == "copy" {
+			return updateNode(root, path, addNode(copyNode(value)))
+		}
+
+		// value can't be moved into its own children.
+		if len(fromPath) < len(path) && reflect.DeepEqual(fromPath, path[:len(fromPath)]) {
+			return nil, ErrPatchPath
+		}
+
+		if root, err = updateNode(root, fromPath, removeNode); err != nil {
+			return nil, err
+		}
+
+		return updateNode(root, path, addNode(value))
+	}
+
+	return nil, ErrPatchOperation
+}
+
+// ParsePointer parses json pointer (RFC 6901) to its reference tokens, eg: /items/0/name is [items 0 name].
+func ParsePointer(pointer string) ([]string, bool) {
+	if pointer == "" {
+		return []string{}, true
+	}
+
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, false
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(tokens[i], "~1", "/"), "~0", "~")
+	}
+
+	return tokens, true
+}
+
+func getNode(node interface{}, path []string) (interface{}, error) {
+	for _, token := range path {
+		child, err := childNode(node, token)
+		if err != nil {
+			return nil, err
+		}
+
+		node = child
+	}
+
+	return node, nil
+}
+
+func updateNode(node interface{}, path []string, fn func(node interface{}, token string) (interface{}, error)) (interface{}, error) {
+	if len(path) == 1 {
+		return fn(node, path[0])
+	}
+
+	child, err := childNode(node, path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if child, err = updateNode(child, path[1:], fn); err != nil {
+		return nil, err
+	}
+
+	return replaceNode(child)(node, path[0])
+}
+
+func childNode(node interface{}, token string) (interface{}, error) {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		if child, ok := v[token]; ok {
+			return child, nil
+		}
+	case []interface{}:
+		if index, ok := arrayIndex(token, len(v)-1); ok {
+			return v[index], nil
+		}
+	}
+
+	return nil, ErrPatchPath
+}
+
+func addNode(value interface{}) func(node interface{}, token string) (interface{}, error) {
+	return func(node interface{}, token string) (interface{}, error) {
+		switch v := node.(type) {
+		case map[string]interface{}:
+			v[token] = value
+			return v, nil
+		case []interface{}:
+			if token == "-" {
+				return append(v, value), nil
+			}
+
+			if index, ok := arrayIndex(token, len(v)); ok {
+				v = append(v, nil)
+				copy(v[index+1:], v[index:])
+				v[index] = value
+				return v, nil
+			}
+		}
+
+		return nil, ErrPatchPath
+	}
+}
+
+func replaceNode(value interface{}) func(node interface{}, token string) (interface{}, error) {
+	return func(node interface{}, token string) (interface{}, error) {
+		if _, err := childNode(node, token); err != nil {
+			return nil, err
+		}
+
+		switch v := node.(type) {
+		case map[string]interface{}:
+			v[token] = value
+			return v, nil
+		default:
+			index, _ := arrayIndex(token, len(v.([]interface{}))-1)
+			v.([]interface{})[index] = value
+			return v, nil
+		}
+	}
+}
+
+func removeNode(node interface{}, token string) (interface{}, error) {
+	if _, err := childNode(node, token); err != nil {
+		return nil, err
+	}
+
+	switch v := node.(type) {
+	case map[string]interface{}:
+		delete(v, token)
+		return v, nil
+	default:
+		s := v.([]interface{})
+		index, _ := arrayIndex(token, len(s)-1)
+		return append(s[:index:index], s[index+1:]...), nil
+	}
+}
+
+// arrayIndex parses array index token, leading zero is not allowed.
+func arrayIndex(token string, max int) (int, bool) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, false
+	}
+
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 || index > max {
+		return 0, false
+	}
+
+	return index, true
+}
+
+func copyNode(node interface{}) interface{} {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			result[key] = copyNode(value)
+		}
+
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i := range v {
+			result[i] = copyNode(v[i])
+		}
+
+		return result
+	}
+
+	return node
+}
+
+// projectPatch copies value located at path from root to result.
+// object is narrowed to the member in the path, while array and other value is copied as a whole.
+func projectPatch(root map[string]interface{}, result map[string]interface{}, path []string) {
+	if len(path) == 0 {
+		return
+	}
+
+	value, exists := root[path[0]]
+	if !exists {
+		result[path[0]] = nil
+		return
+	}
+
+	object, isObject := value.(map[string]interface{})
+	if len(path) == 1 || !isObject {
+		result[path[0]] = copyNode(value)
+		return
+	}
+
+	child, ok := result[path[0]].(map[string]interface{})
+	if !ok {
+		child = make(map[string]interface{})
+		result[path[0]] = child
+	}
+
+	projectPatch(object, child, path[1:])
+}
+
+func decodeJSON(value interface{}) (interface{}, error) {
+	data, ok := value.(json.RawMessage)
+	if !ok {
+		var err error
+		if data, err = json.Marshal(value); err != nil {
+			return nil, err
+		}
+	}
+
+	var result interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// jsonEqual compares decoded json values, numbers are compared by its value.
+func jsonEqual(a interface{}, b interface{}) bool {
+	switch av := a.(type) {
+	case json.Number:
+		bv, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+
+		x, okx := new(big.Float).SetString(string(av))
+		y, oky := new(big.Float).SetString(string(bv))
+		return okx && oky && x.Cmp(y) == 0
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+
+		for key, value := range av {
+			if other, exists := bv[key]; !exists || !jsonEqual(value, other) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+
+		for i := range av {
+			if !jsonEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return a == b
+}
@@ -0,0 +1,129 @@
+package params_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-rel/changeset/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMergePatch(t *testing.T) {
+	p, err := params.ParseMergePatch([]byte(`{"name": "Luffy", "age": null, "address": {"city": "East Blue"}}`))
+	assert.Nil(t, err)
+	assert.Equal(t, params.Present, p.(params.Presencer).Presence("name"))
+	assert.Equal(t, params.Null, p.(params.Presencer).Presence("age"))
+
+	address, valid := p.GetParams("address")
+	assert.True(t, valid)
+	assert.Equal(t, "East Blue", address.Get("city"))
+
+	for _, patch := range []string{`[]`, `null`, `"name"`, `{"name": `} {
+		_, err := params.ParseMergePatch([]byte(patch))
+		assert.Equal(t, params.ErrMalformedPatch, err, patch)
+	}
+}
+
+func TestParsePointer(t *testing.T) {
+	tokens, ok := params.ParsePointer("/items/0/a~1b~0c")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"items", "0", "a/b~c"}, tokens)
+
+	tokens, ok = params.ParsePointer("")
+	assert.True(t, ok)
+	assert.Equal(t, []string{}, tokens)
+
+	_, ok = params.ParsePointer("items")
+	assert.False(t, ok)
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	doc := map[string]interface{}{
+		"name":    "Luffy",
+		"age":     19,
+		"bounty":  1.5,
+		"nick":    "Straw Hat",
+		"tags":    []string{"captain"},
+		"address": map[string]interface{}{"city": "Foosha", "street": "Windmill", "zip": "123"},
+		"crew":    []map[string]interface{}{{"name": "Zoro"}, {"name": "Nami"}},
+	}
+
+	p, err := params.ApplyJSONPatch(doc, []byte(`[
+		{"op": "test", "path": "/age", "value": 19.0},
+		{"op": "replace", "path": "/name", "value": "Monkey D. Luffy"},
+		{"op": "remove", "path": "/nick"},
+		{"op": "add", "path": "/tags/-", "value": "rubber"},
+		{"op": "replace", "path": "/address/city", "value": "East Blue"},
+		{"op": "remove", "path": "/address/zip"},
+		{"op": "move", "from": "/crew/0", "path": "/crew/1"},
+		{"op": "copy", "from": "/bounty", "path": "/reward"}
+	]`))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"address", "crew", "name", "nick", "reward", "tags"}, p.Keys())
+	assert.Equal(t, "Monkey D. Luffy", p.Get("name"))
+	assert.Equal(t, params.Null, p.(params.Presencer).Presence("nick"))
+	assert.Equal(t, 1.5, p.Get("reward"))
+	assert.Equal(t, []interface{}{"captain", "rubber"}, p.Get("tags"))
+
+	address, _ := p.GetParams("address")
+	assert.Equal(t, []string{"city", "zip"}, address.Keys())
+	assert.Equal(t, "East Blue", address.Get("city"))
+	assert.Equal(t, params.Null, address.(params.Presencer).Presence("zip"))
+
+	crew, _ := p.GetParamsSlice("crew")
+	assert.Len(t, crew, 2)
+	assert.Equal(t, "Nami", crew[0].Get("name"))
+	assert.Equal(t, "Zoro", crew[1].Get("name"))
+
+	// patch is applied to a copy of the document.
+	assert.Equal(t, "Luffy", doc["name"])
+}
+
+func TestApplyJSONPatch_error(t *testing.T) {
+	doc := map[string]interface{}{
+		"name":    "Luffy",
+		"tags":    []string{"captain"},
+		"address": map[string]interface{}{"city": "Foosha"},
+	}
+
+	tests := []struct {
+		patch string
+		err   error
+		path  string
+	}{
+		{`{"op": "add"}`, params.ErrMalformedPatch, ""},
+		{`[{"op": "add", "path": "/name"}]`, params.ErrPatchOperation, "/name"},
+		{`[{"op": "rename", "path": "/name"}]`, params.ErrPatchOperation, "/name"},
+		{`[{"path": "/name", "value": 1}]`, params.ErrPatchOperation, "/name"},
+		{`[{"op": "copy", "path": "/name"}]`, params.ErrPatchOperation, "/name"},
+		{`[{"op": "replace", "path": "", "value": {}}]`, params.ErrPatchPath, ""},
+		{`[{"op": "replace", "path": "name", "value": 1}]`, params.ErrPatchPath, "name"},
+		{`[{"op": "replace", "path": "/age", "value": 1}]`, params.ErrPatchPath, "/age"},
+		{`[{"op": "remove", "path": "/address/zip"}]`, params.ErrPatchPath, "/address/zip"},
+		{`[{"op": "add", "path": "/tags/5", "value": "x"}]`, params.ErrPatchPath, "/tags/5"},
+		{`[{"op": "add", "path": "/tags/01", "value": "x"}]`, params.ErrPatchPath, "/tags/01"},
+		{`[{"op": "add", "path": "/missing/city", "value": "x"}]`, params.ErrPatchPath, "/missing/city"},
+		{`[{"op": "move", "from": "/address", "path": "/address/old"}]`, params.ErrPatchPath, "/address/old"},
+		{`[{"op": "move", "from": "/missing", "path": "/name"}]`, params.ErrPatchPath, "/name"},
+		{`[{"op": "test", "path": "/name", "value": "Zoro"}]`, params.ErrPatchTest, "/name"},
+		{`[{"op": "test", "path": "/tags", "value": ["captain", "rubber"]}]`, params.ErrPatchTest, "/tags"},
+		{`[{"op": "replace", "path": "/name", "value": "Zoro"}, {"op": "test", "path": "/name", "value": "Luffy"}]`, params.ErrPatchTest, "/name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.patch, func(t *testing.T) {
+			p, err := params.ApplyJSONPatch(doc, []byte(tt.patch))
+			assert.Nil(t, p)
+			assert.True(t, errors.Is(err, tt.err), err)
+
+			var patchErr params.PatchError
+			if errors.As(err, &patchErr) {
+				assert.Equal(t, tt.path, patchErr.Path)
+			}
+		})
+	}
+
+	_, err := params.ApplyJSONPatch([]string{"name"}, []byte(`[]`))
+	assert.Equal(t, params.ErrMalformedPatch, err)
+}
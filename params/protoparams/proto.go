@@ -0,0 +1,197 @@
+// Package protoparams reads protobuf message as changeset params.
+package protoparams
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/go-rel/changeset/params"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Proto is params backed by protobuf message.
+// Field is resolved by its proto name or json name, and only considered to exist when it's populated,
+// thus unset optional field, unset message field such as wrapper types, and empty repeated field are absent.
+// Timestamp, Duration and wrapper types are converted to time.Time, time.Duration and the wrapped value,
+// enum is converted to its number, then converted to requested type the same way as params.Map.
+type Proto struct {
+	message protoreflect.Message
+}
+
+var (
+	_ params.Params          = Proto{}
+	_ params.Presencer       = Proto{}
+	_ params.ConverterParams = Proto{}
+)
+
+// From returns params backed by protobuf message.
+func From(message proto.Message) Proto {
+	return Proto{message: message.ProtoReflect()}
+}
+
+func (p Proto) field(name string) (protoreflect.FieldDescriptor, bool) {
+	fields := p.message.Descriptor().Fields()
+
+	fd := fields.ByName(protoreflect.Name(name))
+	if fd == nil {
+		fd = fields.ByJSONName(name)
+	}
+
+	if fd == nil || !p.message.Has(fd) {
+		return nil, false
+	}
+
+	return fd, true
+}
+
+// Exists returns true if field exists and populated.
+func (p Proto) Exists(name string) bool {
+	_, exists := p.field(name)
+	return exists
+}
+
+// Presence returns whether field is absent or has a value, protobuf message can't contain explicit null.
+func (p Proto) Presence(name string) params.Presence {
+	if p.Exists(name) {
+		return params.Present
+	}
+
+	return params.Absent
+}
+
+// Keys returns proto name of populated fields, ordered by its declaration.
+func (p Proto) Keys() []string {
+	var (
+		fields = p.message.Descriptor().Fields()
+		keys   []string
+	)
+
+	for i := 0; i < fields.Len(); i++ {
+		if fd := fields.Get(i); p.message.Has(fd) {
+			keys = append(keys, string(fd.Name()))
+		}
+	}
+
+	return keys
+}
+
+// Get returns value as interface.
+// returns nil if value doens't exists.
+func (p Proto) Get(name string) interface{} {
+	fd, exists := p.field(name)
+	if !exists {
+		return nil
+	}
+
+	return protoValue(fd, p.message.Get(fd))
+}
+
+// GetWithType returns value given from given name and type.
+// second return value will only be false if the type of parameter is not convertible to requested type.
+// If value is not convertible to type, it'll return nil, false
+// If value is not exists, it will return nil, true
+func (p Proto) GetWithType(name string, typ reflect.Type) (interface{}, bool) {
+	return p.GetWithConverters(name, typ, nil)
+}
+
+// GetWithConverters returns value given from given name and type, converted using converters when available.
+func (p Proto) GetWithConverters(name string, typ reflect.Type, converters params.Converters) (interface{}, bool) {
+	return params.GetWithConverters(params.Map{name: p.Get(name)}, name, typ, converters)
+}
+
+// GetParams returns nested param, map field is returned as params.Map.
+func (p Proto) GetParams(name string) (params.Params, bool) {
+	fd, exists := p.field(name)
+	if !exists || fd.IsList() {
+		return nil, false
+	}
+
+	switch value := protoValue(fd, p.message.Get(fd)).(type) {
+	case Proto:
+		return value, true
+	case map[string]interface{}:
+		return params.Map(value), true
+	}
+
+	return nil, false
+}
+
+// GetParamsSlice returns slice of nested param
+func (p Proto) GetParamsSlice(name string) ([]params.Params, bool) {
+	fd, exists := p.field(name)
+	if !exists || !fd.IsList() || fd.Message() == nil {
+		return nil, false
+	}
+
+	var (
+		list = p.message.Get(fd).List()
+		pars = make([]params.Params, list.Len())
+	)
+
+	for i := range pars {
+		pars[i] = Proto{message: list.Get(i).Message()}
+	}
+
+	return pars, true
+}
+
+func protoValue(fd protoreflect.FieldDescriptor, value protoreflect.Value) interface{} {
+	switch {
+	case fd.IsList():
+		list := value.List()
+		result := make([]interface{}, list.Len())
+		for i := range result {
+			result[i] = protoSingular(fd, list.Get(i))
+		}
+
+		return result
+	case fd.IsMap():
+		result := make(map[string]interface{}, value.Map().Len())
+		value.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+			result[key.String()] = protoSingular(fd.MapValue(), value)
+			return true
+		})
+
+		return result
+	}
+
+	return protoSingular(fd, value)
+}
+
+func protoSingular(fd protoreflect.FieldDescriptor, value protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return protoMessage(value.Message())
+	case protoreflect.EnumKind:
+		return int32(value.Enum())
+	}
+
+	return value.Interface()
+}
+
+// protoMessage converts well-known types to its go value, other message is returned as Proto.
+func protoMessage(message protoreflect.Message) interface{} {
+	var (
+		desc   = message.Descriptor()
+		fields = desc.Fields()
+	)
+
+	switch desc.FullName() {
+	case "google.protobuf.Timestamp":
+		seconds := message.Get(fields.ByName("seconds")).Int()
+		nanos := message.Get(fields.ByName("nanos")).Int()
+		return time.Unix(seconds, nanos).UTC()
+	case "google.protobuf.Duration":
+		seconds := message.Get(fields.ByName("seconds")).Int()
+		nanos := message.Get(fields.ByName("nanos")).Int()
+		return time.Duration(seconds)*time.Second + time.Duration(nanos)
+	case "google.protobuf.DoubleValue", "google.protobuf.FloatValue",
+		"google.protobuf.Int64Value", "google.protobuf.UInt64Value",
+		"google.protobuf.Int32Value", "google.protobuf.UInt32Value",
+		"google.protobuf.BoolValue", "google.protobuf.StringValue", "google.protobuf.BytesValue":
+		return message.Get(fields.ByName("value")).Interface()
+	}
+
+	return Proto{message: message}
+}
@@ -0,0 +1,235 @@
+package protoparams_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-rel/changeset/params"
+	"github.com/go-rel/changeset/params/protoparams"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type Number int
+
+func protoField(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+	field := &descriptorpb.FieldDescriptorProto{
+		Name:   proto.String(name),
+		Number: proto.Int32(number),
+		Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:   typ.Enum(),
+	}
+
+	if typeName != "" {
+		field.TypeName = proto.String(typeName)
+	}
+
+	return field
+}
+
+func repeated(field *descriptorpb.FieldDescriptorProto) *descriptorpb.FieldDescriptorProto {
+	field.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	return field
+}
+
+// userDescriptor describes the following proto3 message:
+//
+//	message Address { string city = 1; }
+//	enum Role { ROLE_UNKNOWN = 0; ROLE_ADMIN = 1; }
+//	message User {
+//		string name = 1;
+//		string display_name = 2;
+//		int32 age = 3;
+//		optional string nickname = 4;
+//		google.protobuf.Timestamp born = 5;
+//		google.protobuf.Duration timeout = 6;
+//		google.protobuf.DoubleValue score = 7;
+//		google.protobuf.StringValue bio = 8;
+//		Address address = 9;
+//		repeated Address addresses = 10;
+//		repeated string tags = 11;
+//		Role role = 12;
+//		map<string, string> labels = 13;
+//	}
+func userDescriptor(t *testing.T) protoreflect.MessageDescriptor {
+	const (
+		message = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+		str     = descriptorpb.FieldDescriptorProto_TYPE_STRING
+	)
+
+	nickname := protoField("nickname", 4, str, "")
+	nickname.OneofIndex = proto.Int32(0)
+	nickname.Proto3Optional = proto.Bool(true)
+
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("user.proto"),
+		Package: proto.String("test"),
+		Syntax:  proto.String("proto3"),
+		Dependency: []string{
+			"google/protobuf/timestamp.proto",
+			"google/protobuf/duration.proto",
+			"google/protobuf/wrappers.proto",
+		},
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name: proto.String("Role"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: proto.String("ROLE_UNKNOWN"), Number: proto.Int32(0)},
+				{Name: proto.String("ROLE_ADMIN"), Number: proto.Int32(1)},
+			},
+		}},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name:  proto.String("Address"),
+				Field: []*descriptorpb.FieldDescriptorProto{protoField("city", 1, str, "")},
+			},
+			{
+				Name: proto.String("User"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					protoField("name", 1, str, ""),
+					protoField("display_name", 2, str, ""),
+					protoField("age", 3, descriptorpb.FieldDescriptorProto_TYPE_INT32, ""),
+					nickname,
+					protoField("born", 5, message, ".google.protobuf.Timestamp"),
+					protoField("timeout", 6, message, ".google.protobuf.Duration"),
+					protoField("score", 7, message, ".google.protobuf.DoubleValue"),
+					protoField("bio", 8, message, ".google.protobuf.StringValue"),
+					protoField("address", 9, message, ".test.Address"),
+					repeated(protoField("addresses", 10, message, ".test.Address")),
+					repeated(protoField("tags", 11, str, "")),
+					protoField("role", 12, descriptorpb.FieldDescriptorProto_TYPE_ENUM, ".test.Role"),
+					repeated(protoField("labels", 13, message, ".test.User.LabelsEntry")),
+				},
+				NestedType: []*descriptorpb.DescriptorProto{{
+					Name:    proto.String("LabelsEntry"),
+					Field:   []*descriptorpb.FieldDescriptorProto{protoField("key", 1, str, ""), protoField("value", 2, str, "")},
+					Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+				}},
+				OneofDecl: []*descriptorpb.OneofDescriptorProto{{Name: proto.String("_nickname")}},
+			},
+		},
+	}
+
+	fd, err := protodesc.NewFile(file, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return fd.Messages().ByName("User")
+}
+
+func newUserProto(t *testing.T, data string) proto.Message {
+	message := dynamicpb.NewMessage(userDescriptor(t))
+	if err := protojson.Unmarshal([]byte(data), message); err != nil {
+		t.Fatal(err)
+	}
+
+	return message
+}
+
+func TestProto(t *testing.T) {
+	p := protoparams.From(newUserProto(t, `{
+		"name": "Luffy",
+		"displayName": "Straw Hat",
+		"nickname": "",
+		"born": "2016-11-28T16:00:00Z",
+		"timeout": "1.5s",
+		"score": 1.5,
+		"address": {"city": "East Blue"},
+		"addresses": [{"city": "Foosha"}, {"city": "Fuschia"}],
+		"tags": ["captain", "rubber"],
+		"role": "ROLE_ADMIN",
+		"labels": {"crew": "Straw Hat Pirates"}
+	}`))
+
+	born := time.Date(2016, 11, 28, 16, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, []string{"name", "display_name", "nickname", "born", "timeout", "score", "address", "addresses", "tags", "role", "labels"}, p.Keys())
+	assert.True(t, p.Exists("display_name"))
+	assert.True(t, p.Exists("displayName"))
+	assert.Equal(t, params.Present, p.Presence("nickname"))
+	assert.Equal(t, params.Absent, p.Presence("age"))
+	assert.Equal(t, params.Absent, p.Presence("bio"))
+	assert.Equal(t, params.Absent, p.Presence("not_exists"))
+	assert.Equal(t, "Straw Hat", p.Get("displayName"))
+	assert.Nil(t, p.Get("bio"))
+
+	tests := []struct {
+		field string
+		typ   reflect.Type
+		value interface{}
+		valid bool
+	}{
+		{"name", reflect.TypeOf(""), "Luffy", true},
+		{"nickname", reflect.TypeOf(""), "", true},
+		{"age", reflect.TypeOf(0), nil, true},
+		{"born", reflect.TypeOf(time.Time{}), born, true},
+		{"timeout", reflect.TypeOf(time.Duration(0)), 1500 * time.Millisecond, true},
+		{"score", reflect.TypeOf(float32(0)), float32(1.5), true},
+		{"score", reflect.TypeOf(0), nil, false},
+		{"bio", reflect.TypeOf(""), nil, true},
+		{"tags", reflect.TypeOf([]string{}), []string{"captain", "rubber"}, true},
+		{"role", reflect.TypeOf(0), 1, true},
+		{"role", reflect.TypeOf(Number(0)), Number(1), true},
+		{"address", reflect.TypeOf(""), nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field+" "+tt.typ.String(), func(t *testing.T) {
+			value, valid := p.GetWithType(tt.field, tt.typ)
+			assert.Equal(t, tt.value, value)
+			assert.Equal(t, tt.valid, valid)
+		})
+	}
+
+	name, valid := params.GetWithConverters(p, "name", reflect.TypeOf(""), params.Converters{
+		reflect.TypeOf(""): func(raw interface{}) (interface{}, bool) { return "Captain " + raw.(string), true },
+	})
+	assert.True(t, valid)
+	assert.Equal(t, "Captain Luffy", name)
+
+	address, valid := p.GetParams("address")
+	assert.True(t, valid)
+	assert.Equal(t, "East Blue", address.Get("city"))
+
+	labels, valid := p.GetParams("labels")
+	assert.True(t, valid)
+	assert.Equal(t, "Straw Hat Pirates", labels.Get("crew"))
+
+	_, valid = p.GetParams("addresses")
+	assert.False(t, valid)
+
+	_, valid = p.GetParams("bio")
+	assert.False(t, valid)
+
+	addresses, valid := p.GetParamsSlice("addresses")
+	assert.True(t, valid)
+	assert.Len(t, addresses, 2)
+	assert.Equal(t, "Fuschia", addresses[1].Get("city"))
+
+	_, valid = p.GetParamsSlice("tags")
+	assert.False(t, valid)
+
+	_, valid = p.GetParamsSlice("address")
+	assert.False(t, valid)
+}
+
+func TestProto_wrapper(t *testing.T) {
+	p := protoparams.From(newUserProto(t, `{"bio": ""}`))
+
+	assert.Equal(t, []string{"bio"}, p.Keys())
+	assert.Equal(t, params.Present, p.Presence("bio"))
+
+	value, valid := p.GetWithType("bio", reflect.TypeOf(""))
+	assert.True(t, valid)
+	assert.Equal(t, "", value)
+}
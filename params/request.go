@@ -0,0 +1,181 @@
+package params
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+var (
+	// ErrMalformedBody is returned when request body can't be decoded.
+	ErrMalformedBody = errors.New("params: malformed request body")
+	// ErrBodyTooLarge is returned when request body exceeds the size limit.
+	ErrBodyTooLarge = errors.New("params: request body too large")
+	// ErrUnsupportedMediaType is returned when request content type is not supported.
+	ErrUnsupportedMediaType = errors.New("params: unsupported media type")
+)
+
+// DefaultMaxBodySize is the default size limit of request body used by FromRequest.
+var DefaultMaxBodySize int64 = 10 << 20
+
+// RequestError is returned by FromRequest, Status is the suggested http status code for the response.
+type RequestError struct {
+	Status int
+	Err    error
+}
+
+// Error prints error message.
+func (e RequestError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap internal error.
+func (e RequestError) Unwrap() error {
+	return e.Err
+}
+
+type requestOptions struct {
+	maxBodySize int64
+	maxMemory   int64
+	limits      Limits
+}
+
+// RequestOption for FromRequest.
+type RequestOption func(*requestOptions)
+
+// MaxBodySize limits the size of request body, default to DefaultMaxBodySize.
+func MaxBodySize(size int64) RequestOption {
+	return func(opts *requestOptions) {
+		opts.maxBodySize = size
+	}
+}
+
+// MaxMemory limits the size of multipart form stored in memory, the rest is stored in temporary files.
+// default to 32MB.
+func MaxMemory(size int64) RequestOption {
+	return func(opts *requestOptions) {
+		opts.maxMemory = size
+	}
+}
+
+// Limit restricts the size of decoded params, default to DefaultLimits.
+func Limit(limits Limits) RequestOption {
+	return func(opts *requestOptions) {
+		opts.limits = limits
+	}
+}
+
+// FromRequest decodes params from request body according to its Content-Type.
+// application/json (and any +json type) is decoded as JSON, application/x-www-form-urlencoded as Form
+// and multipart/form-data using ParseMultipartForm. GET, HEAD and DELETE request, request without Content-Type
+// and request with empty body uses its query parameters.
+// Multipart form is assigned to r.MultipartForm, so its temporary files are removed by http server.
+// Params that exceeds the limits is rejected with LimitError.
+func FromRequest(r *http.Request, opts ...RequestOption) (Params, error) {
+	options := requestOptions{
+		maxBodySize: DefaultMaxBodySize,
+		maxMemory:   32 << 20,
+		limits:      DefaultLimits,
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	if contentType == "" || !hasBody(r) {
+		return limitError(options.limits.ParseForm(r.URL.Query()))
+	}
+
+	mediaType, mediaParams, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, RequestError{Status: http.StatusUnsupportedMediaType, Err: ErrUnsupportedMediaType}
+	}
+
+	src := r.Body
+	if src == nil {
+		src = http.NoBody
+	}
+
+	body := http.MaxBytesReader(nil, src, options.maxBodySize)
+	defer body.Close()
+
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return nil, requestError(err)
+		}
+
+		if len(data) == 0 {
+			return limitError(options.limits.ParseForm(r.URL.Query()))
+		}
+
+		if !gjson.ValidBytes(data) {
+			return nil, RequestError{Status: http.StatusBadRequest, Err: ErrMalformedBody}
+		}
+
+		return limitError(options.limits.ParseJSON(string(data)))
+	case mediaType == "application/x-www-form-urlencoded":
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return nil, requestError(err)
+		}
+
+		values, err := url.ParseQuery(string(data))
+		if err != nil {
+			return nil, RequestError{Status: http.StatusBadRequest, Err: ErrMalformedBody}
+		}
+
+		return limitError(options.limits.ParseForm(values))
+	case mediaType == "multipart/form-data":
+		boundary := mediaParams["boundary"]
+		if boundary == "" {
+			return nil, RequestError{Status: http.StatusBadRequest, Err: ErrMalformedBody}
+		}
+
+		form, err := multipart.NewReader(body, boundary).ReadForm(options.maxMemory)
+		if err != nil {
+			return nil, requestError(err)
+		}
+
+		r.MultipartForm = form
+		return limitError(options.limits.ParseMultipartForm(form))
+	}
+
+	return nil, RequestError{Status: http.StatusUnsupportedMediaType, Err: ErrUnsupportedMediaType}
+}
+
+// hasBody returns false if request method doesn't use body or the body is known to be empty.
+func hasBody(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+		return false
+	}
+
+	return r.Body != nil && r.Body != http.NoBody && r.ContentLength != 0
+}
+
+func requestError(err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return RequestError{Status: http.StatusRequestEntityTooLarge, Err: ErrBodyTooLarge}
+	}
+
+	return RequestError{Status: http.StatusBadRequest, Err: ErrMalformedBody}
+}
+
+// limitError wraps LimitError as RequestError.
+func limitError(p Params, err error) (Params, error) {
+	if err != nil {
+		return nil, RequestError{Status: http.StatusRequestEntityTooLarge, Err: err}
+	}
+
+	return p, nil
+}
@@ -0,0 +1,207 @@
+package params_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-rel/changeset/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		target      string
+		contentType string
+		body        string
+	}{
+		{"json", "POST", "/", "application/json", `{"name": "Luffy", "age": 20}`},
+		{"json charset", "PUT", "/", "application/json; charset=utf-8", `{"name": "Luffy", "age": 20}`},
+		{"json suffix", "PATCH", "/", "application/merge-patch+json", `{"name": "Luffy", "age": 20}`},
+		{"form", "POST", "/", "application/x-www-form-urlencoded", "name=Luffy&age=20"},
+		{"query", "GET", "/?name=Luffy&age=20", "", ""},
+		{"query with content type", "GET", "/?name=Luffy&age=20", "application/json", ""},
+		{"query of delete", "DELETE", "/?name=Luffy&age=20", "application/json", `{"name": "Zoro"}`},
+		{"query with empty body", "POST", "/?name=Luffy&age=20", "application/json", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+
+			p, err := params.FromRequest(req)
+			assert.Nil(t, err)
+
+			name, valid := p.GetWithType("name", reflect.TypeOf(""))
+			assert.True(t, valid)
+			assert.Equal(t, "Luffy", name)
+
+			age, valid := p.GetWithType("age", reflect.TypeOf(0))
+			assert.True(t, valid)
+			assert.Equal(t, 20, age)
+		})
+	}
+}
+
+func TestFromRequest_multipart(t *testing.T) {
+	var (
+		body   bytes.Buffer
+		writer = multipart.NewWriter(&body)
+	)
+
+	writer.WriteField("user[name]", "Luffy")
+	w, _ := writer.CreateFormFile("user[avatar]", "avatar.png")
+	w.Write([]byte("avatar"))
+	writer.Close()
+
+	req := httptest.NewRequest("POST", "/", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	p, err := params.FromRequest(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, req.MultipartForm)
+	defer req.MultipartForm.RemoveAll()
+
+	user, ok := p.GetParams("user")
+	assert.True(t, ok)
+	assert.Equal(t, []interface{}{"Luffy"}, user.Get("name"))
+
+	avatar, valid := user.GetWithType("avatar", reflect.TypeOf(multipart.FileHeader{}))
+	assert.True(t, valid)
+	assert.Equal(t, "avatar.png", avatar.(multipart.FileHeader).Filename)
+}
+
+func TestFromRequest_error(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		opts        []params.RequestOption
+		status      int
+		err         error
+	}{
+		{"malformed json", "application/json", `{"name": `, nil, http.StatusBadRequest, params.ErrMalformedBody},
+		{"malformed form", "application/x-www-form-urlencoded", "name=%zz", nil, http.StatusBadRequest, params.ErrMalformedBody},
+		{"missing boundary", "multipart/form-data", "name", nil, http.StatusBadRequest, params.ErrMalformedBody},
+		{"malformed multipart", "multipart/form-data; boundary=xyz", "invalid", nil, http.StatusBadRequest, params.ErrMalformedBody},
+		{"json too large", "application/json", `{"name": "Luffy"}`, []params.RequestOption{params.MaxBodySize(5)}, http.StatusRequestEntityTooLarge, params.ErrBodyTooLarge},
+		{"form too large", "application/x-www-form-urlencoded", "name=Luffy", []params.RequestOption{params.MaxBodySize(5)}, http.StatusRequestEntityTooLarge, params.ErrBodyTooLarge},
+		{"unsupported", "text/plain", "name", nil, http.StatusUnsupportedMediaType, params.ErrUnsupportedMediaType},
+		{"invalid content type", "application/", "name", nil, http.StatusUnsupportedMediaType, params.ErrUnsupportedMediaType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+
+			p, err := params.FromRequest(req, tt.opts...)
+			assert.Nil(t, p)
+			assert.Equal(t, params.RequestError{Status: tt.status, Err: tt.err}, err)
+			assert.True(t, errors.Is(err, tt.err))
+			assert.Equal(t, tt.err.Error(), err.Error())
+		})
+	}
+}
+
+func TestFromRequest_emptyChunkedBody(t *testing.T) {
+	req := httptest.NewRequest("POST", "/?name=Luffy", io.NopCloser(strings.NewReader("")))
+	req.Header.Set("Content-Type", "application/json")
+	req.ContentLength = -1
+
+	p, err := params.FromRequest(req)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"Luffy"}, p.Get("name"))
+}
+
+func TestFromRequest_multipartTooLarge(t *testing.T) {
+	var (
+		body   bytes.Buffer
+		writer = multipart.NewWriter(&body)
+	)
+
+	w, _ := writer.CreateFormFile("avatar", "avatar.png")
+	w.Write(bytes.Repeat([]byte("a"), 1024))
+	writer.Close()
+
+	req := httptest.NewRequest("POST", "/", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	_, err := params.FromRequest(req, params.MaxBodySize(512), params.MaxMemory(256))
+	assert.Equal(t, params.RequestError{Status: http.StatusRequestEntityTooLarge, Err: params.ErrBodyTooLarge}, err)
+}
+
+func TestFromRequest_limit(t *testing.T) {
+	limits := params.Limits{MaxDepth: 2}
+	tests := []struct {
+		name        string
+		target      string
+		contentType string
+		body        string
+		key         string
+	}{
+		{"json", "/", "application/json", `{"a": {"b": {"c": 1}}}`, "a.b.c"},
+		{"form", "/", "application/x-www-form-urlencoded", "a[b][c]=1", "a[b][c]"},
+		{"query", "/?a[b][c]=1", "", "", "a[b][c]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", tt.target, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+
+			p, err := params.FromRequest(req, params.Limit(limits))
+			assert.Nil(t, p)
+			assert.Equal(t, params.RequestError{
+				Status: http.StatusRequestEntityTooLarge,
+				Err:    params.LimitError{Limit: params.LimitDepth, Max: 2, Key: tt.key},
+			}, err)
+
+			var limitErr params.LimitError
+			assert.True(t, errors.As(err, &limitErr))
+		})
+	}
+}
+
+func TestFromRequest_defaultLimit(t *testing.T) {
+	req := httptest.NewRequest("POST", "/", strings.NewReader("items[999999999][name]=Luffy"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	_, err := params.FromRequest(req)
+	assert.Equal(t, params.RequestError{
+		Status: http.StatusRequestEntityTooLarge,
+		Err:    params.LimitError{Limit: params.LimitIndex, Max: params.DefaultLimits.MaxIndex, Key: "items[999999999][name]"},
+	}, err)
+}
+
+func TestFromRequest_multipartLimit(t *testing.T) {
+	var (
+		body   bytes.Buffer
+		writer = multipart.NewWriter(&body)
+	)
+
+	writer.WriteField("a[b][c]", "1")
+	writer.Close()
+
+	req := httptest.NewRequest("POST", "/", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	_, err := params.FromRequest(req, params.Limit(params.Limits{MaxDepth: 2}))
+	assert.Equal(t, params.RequestError{
+		Status: http.StatusRequestEntityTooLarge,
+		Err:    params.LimitError{Limit: params.LimitDepth, Max: 2, Key: "a[b][c]"},
+	}, err)
+}
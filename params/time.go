@@ -0,0 +1,80 @@
+package params
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Epoch is the unit of numeric time param.
+type Epoch int
+
+const (
+	// EpochNone doesn't accept numeric time param.
+	EpochNone Epoch = iota
+	// EpochSeconds parses numeric time param as seconds since unix epoch.
+	EpochSeconds
+	// EpochMillis parses numeric time param as milliseconds since unix epoch.
+	EpochMillis
+)
+
+// TimeFormat defines how time param is parsed.
+type TimeFormat struct {
+	// Layouts accepted, tried in order.
+	Layouts []string
+	// Location used for layouts without time zone, and for the result of epoch. default to UTC.
+	Location *time.Location
+	// Epoch unit of numeric param, numeric param is invalid if not set.
+	Epoch Epoch
+}
+
+// DefaultTimeFormat is used by every params when converting time.
+var DefaultTimeFormat = TimeFormat{
+	Layouts: []string{time.RFC3339},
+}
+
+// Convert raw param value to time.Time, it can be used as ConvertFunc.
+func (f TimeFormat) Convert(raw interface{}) (interface{}, bool) {
+	loc := f.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	switch v := raw.(type) {
+	case string:
+		v = strings.TrimSpace(v)
+		for _, layout := range f.Layouts {
+			if t, err := time.ParseInLocation(layout, v, loc); err == nil {
+				return t, true
+			}
+		}
+	case time.Time:
+		return v, true
+	default:
+		rv := reflect.ValueOf(raw)
+
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return f.epoch(float64(rv.Int()), loc)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return f.epoch(float64(rv.Uint()), loc)
+		case reflect.Float32, reflect.Float64:
+			return f.epoch(rv.Float(), loc)
+		}
+	}
+
+	return nil, false
+}
+
+func (f TimeFormat) epoch(value float64, loc *time.Location) (interface{}, bool) {
+	switch f.Epoch {
+	case EpochSeconds:
+		sec, frac := math.Modf(value)
+		return time.Unix(int64(sec), int64(frac*1e9)).In(loc), true
+	case EpochMillis:
+		return time.UnixMilli(int64(value)).In(loc), true
+	}
+
+	return nil, false
+}
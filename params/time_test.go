@@ -0,0 +1,96 @@
+package params_test
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-rel/changeset/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeFormat_Convert(t *testing.T) {
+	var (
+		jakarta = time.FixedZone("WIB", 7*60*60)
+		format  = params.TimeFormat{
+			Layouts:  []string{"2006-01-02", "2006-01-02T15:04", time.RFC3339},
+			Location: jakarta,
+			Epoch:    params.EpochSeconds,
+		}
+		tests = []struct {
+			name     string
+			format   params.TimeFormat
+			raw      interface{}
+			expected interface{}
+			valid    bool
+		}{
+			{"date", format, "2020-01-02", time.Date(2020, 1, 2, 0, 0, 0, 0, jakarta), true},
+			{"datetime-local", format, "2020-01-02T15:04", time.Date(2020, 1, 2, 15, 4, 0, 0, jakarta), true},
+			{"rfc3339", format, "2020-01-02T15:04:05Z", time.Date(2020, 1, 2, 15, 4, 5, 0, time.UTC), true},
+			{"invalid layout", format, "02/01/2020", nil, false},
+			{"time", format, time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), true},
+			{"epoch seconds", format, float64(1577923200), time.Unix(1577923200, 0).In(jakarta), true},
+			{"epoch seconds fraction", format, 1577923200.5, time.Unix(1577923200, 5e8).In(jakarta), true},
+			{"epoch seconds int", format, int64(1577923200), time.Unix(1577923200, 0).In(jakarta), true},
+			{"epoch millis", params.TimeFormat{Epoch: params.EpochMillis}, 1577923200123, time.UnixMilli(1577923200123).UTC(), true},
+			{"epoch none", params.TimeFormat{}, 1577923200, nil, false},
+			{"default location", params.TimeFormat{Layouts: []string{"2006-01-02"}}, "2020-01-02", time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), true},
+			{"invalid type", format, true, nil, false},
+		}
+	)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, valid := tt.format.Convert(tt.raw)
+			assert.Equal(t, tt.valid, valid)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestDefaultTimeFormat(t *testing.T) {
+	defer func(format params.TimeFormat) {
+		params.DefaultTimeFormat = format
+	}(params.DefaultTimeFormat)
+
+	params.DefaultTimeFormat = params.TimeFormat{
+		Layouts: []string{"2006-01-02"},
+		Epoch:   params.EpochMillis,
+	}
+
+	var (
+		typ    = reflect.TypeOf(time.Time{})
+		date   = time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+		millis = time.UnixMilli(1577923200123).UTC()
+		form   = params.ParseForm(url.Values{"date": {"2020-01-02"}, "rfc3339": {"2020-01-02T00:00:00Z"}})
+		json   = params.ParseJSON(`{"date": "2020-01-02", "millis": 1577923200123}`)
+		m      = params.Map{"date": "2020-01-02", "millis": 1577923200123}
+		result interface{}
+		valid  bool
+	)
+
+	result, valid = form.GetWithType("date", typ)
+	assert.True(t, valid)
+	assert.Equal(t, date, result)
+
+	result, valid = form.GetWithType("rfc3339", typ)
+	assert.False(t, valid)
+	assert.Nil(t, result)
+
+	result, valid = json.GetWithType("date", typ)
+	assert.True(t, valid)
+	assert.Equal(t, date, result)
+
+	result, valid = json.GetWithType("millis", typ)
+	assert.True(t, valid)
+	assert.Equal(t, millis, result)
+
+	result, valid = m.GetWithType("date", typ)
+	assert.True(t, valid)
+	assert.Equal(t, date, result)
+
+	result, valid = m.GetWithType("millis", typ)
+	assert.True(t, valid)
+	assert.Equal(t, millis, result)
+}
@@ -0,0 +1,18 @@
+// Package toml parses TOML document as changeset params.
+package toml
+
+import (
+	"github.com/BurntSushi/toml"
+	"github.com/go-rel/changeset/params"
+)
+
+// Parse TOML document as params.
+// TOML document is parsed as JSON params, thus it uses the same conversion rules.
+func Parse(data []byte) (params.Params, error) {
+	var doc map[string]interface{}
+	if err := toml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	return params.ParseDocument(doc)
+}
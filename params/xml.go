@@ -0,0 +1,91 @@
+package params
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+)
+
+// XMLTextKey is the key used for text of xml element that also has attributes or child elements.
+const XMLTextKey = "#text"
+
+// ErrXMLNoRoot is returned when xml document doesn't contain root element.
+var ErrXMLNoRoot = errors.New("params: xml document has no root element")
+
+// ParseXML parses xml document as Form.
+// Attributes and child elements of the root element are assigned using its local name,
+// repeated elements are assigned as multiple values and accessible using GetParamsSlice.
+// Element that only contains text is assigned as string, and converted the same way as ParseForm.
+func ParseXML(data []byte) (Form, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil, ErrXMLNoRoot
+		} else if err != nil {
+			return nil, err
+		}
+
+		if start, ok := token.(xml.StartElement); ok {
+			value, err := parseXMLElement(decoder, start)
+			if err != nil {
+				return nil, err
+			}
+
+			if form, ok := value.(Form); ok {
+				return form, nil
+			}
+
+			return Form{XMLTextKey: {value}}, nil
+		}
+	}
+}
+
+// parseXMLElement returns Form for element with attributes or child elements, otherwise returns its text.
+func parseXMLElement(decoder *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	var (
+		form = Form{}
+		text strings.Builder
+	)
+
+	for _, attr := range start.Attr {
+		if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+			continue
+		}
+
+		form[attr.Name.Local] = append(form[attr.Name.Local], attr.Value)
+	}
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			value, err := parseXMLElement(decoder, t)
+			if err != nil {
+				return nil, err
+			}
+
+			form[t.Name.Local] = append(form[t.Name.Local], value)
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			str := strings.TrimSpace(text.String())
+			if len(form) == 0 {
+				return str, nil
+			}
+
+			if str != "" {
+				form[XMLTextKey] = append(form[XMLTextKey], str)
+			}
+
+			return form, nil
+		}
+	}
+}
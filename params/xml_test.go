@@ -0,0 +1,104 @@
+package params_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-rel/changeset/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseXML(t *testing.T) {
+	p, err := params.ParseXML([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<user id="10" xmlns="http://example.com/user">
+	<name>Luffy</name>
+	<age> 19 </age>
+	<active>true</active>
+	<born>2016-11-28T23:00:00+07:00</born>
+	<tag>captain</tag>
+	<tag>rubber</tag>
+	<empty/>
+	<address>
+		<city>East Blue</city>
+		<geo lat="1.5" lng="2.5"/>
+	</address>
+	<crew><name>Zoro</name></crew>
+	<crew><name>Nami</name></crew>
+	<bounty currency="berry">1500000000</bounty>
+</user>`))
+
+	born, _ := time.Parse(time.RFC3339, "2016-11-28T23:00:00+07:00")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"active", "address", "age", "born", "bounty", "crew", "empty", "id", "name", "tag"}, p.Keys())
+	assert.Equal(t, params.Present, p.Presence("empty"))
+
+	tests := []struct {
+		field string
+		typ   reflect.Type
+		value interface{}
+		valid bool
+	}{
+		{"id", reflect.TypeOf(0), 10, true},
+		{"name", reflect.TypeOf(""), "Luffy", true},
+		{"age", reflect.TypeOf(0), 19, true},
+		{"age", reflect.TypeOf(Number(0)), Number(19), true},
+		{"name", reflect.TypeOf(0), nil, false},
+		{"active", reflect.TypeOf(true), true, true},
+		{"born", reflect.TypeOf(time.Time{}), born, true},
+		{"tag", reflect.TypeOf([]string{}), []string{"captain", "rubber"}, true},
+		{"empty", reflect.TypeOf(""), "", true},
+		{"address", reflect.TypeOf(""), nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field+" "+tt.typ.String(), func(t *testing.T) {
+			value, valid := p.GetWithType(tt.field, tt.typ)
+			assert.Equal(t, tt.value, value)
+			assert.Equal(t, tt.valid, valid)
+		})
+	}
+
+	address, valid := p.GetParams("address")
+	assert.True(t, valid)
+	assert.Equal(t, []interface{}{"East Blue"}, address.Get("city"))
+
+	geo, valid := address.GetParams("geo")
+	assert.True(t, valid)
+	lat, valid := geo.GetWithType("lat", reflect.TypeOf(float64(0)))
+	assert.True(t, valid)
+	assert.Equal(t, 1.5, lat)
+
+	crews, valid := p.GetParamsSlice("crew")
+	assert.True(t, valid)
+	assert.Len(t, crews, 2)
+	assert.Equal(t, []interface{}{"Nami"}, crews[1].Get("name"))
+
+	_, valid = p.GetParamsSlice("tag")
+	assert.False(t, valid)
+
+	bounty, valid := p.GetParams("bounty")
+	assert.True(t, valid)
+	amount, valid := bounty.GetWithType(params.XMLTextKey, reflect.TypeOf(int64(0)))
+	assert.True(t, valid)
+	assert.Equal(t, int64(1500000000), amount)
+	assert.Equal(t, []interface{}{"berry"}, bounty.Get("currency"))
+}
+
+func TestParseXML_text(t *testing.T) {
+	p, err := params.ParseXML([]byte(`<name>Luffy</name>`))
+	assert.Nil(t, err)
+	assert.Equal(t, params.Form{params.XMLTextKey: {"Luffy"}}, p)
+}
+
+func TestParseXML_invalid(t *testing.T) {
+	_, err := params.ParseXML([]byte(`<user><name>Luffy</user>`))
+	assert.NotNil(t, err)
+
+	_, err = params.ParseXML([]byte(`<user><name>Luffy</name>`))
+	assert.NotNil(t, err)
+
+	_, err = params.ParseXML([]byte(`<!-- empty -->`))
+	assert.Equal(t, params.ErrXMLNoRoot, err)
+}
@@ -0,0 +1,18 @@
+// Package yaml parses YAML document as changeset params.
+package yaml
+
+import (
+	"github.com/go-rel/changeset/params"
+	"gopkg.in/yaml.v3"
+)
+
+// Parse YAML document as params.
+// YAML document is parsed as JSON params, thus it uses the same conversion rules.
+func Parse(data []byte) (params.Params, error) {
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	return params.ParseDocument(doc)
+}
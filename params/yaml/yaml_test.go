@@ -0,0 +1,95 @@
+package yaml_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-rel/changeset/params"
+	"github.com/go-rel/changeset/params/yaml"
+	"github.com/stretchr/testify/assert"
+)
+
+type Number int
+
+func TestParse(t *testing.T) {
+	p, err := yaml.Parse([]byte(`
+name: Luffy
+age: 19
+score: 1.5
+active: true
+nil: ~
+born: 2016-11-28T23:00:00+07:00
+tags: [captain, rubber]
+1: numeric key
+address:
+  city: East Blue
+  geo:
+    lat: 1.5
+crews:
+  - name: Zoro
+  - name: Nami
+`))
+
+	born, _ := time.Parse(time.RFC3339, "2016-11-28T23:00:00+07:00")
+
+	assert.Nil(t, err)
+	assert.Equal(t, params.Present, p.(params.Presencer).Presence("name"))
+	assert.Equal(t, params.Null, p.(params.Presencer).Presence("nil"))
+	assert.Equal(t, params.Absent, p.(params.Presencer).Presence("not-exists"))
+	assert.Equal(t, "numeric key", p.Get("1"))
+
+	tests := []struct {
+		field string
+		typ   reflect.Type
+		value interface{}
+		valid bool
+	}{
+		{"name", reflect.TypeOf(""), "Luffy", true},
+		{"age", reflect.TypeOf(0), 19, true},
+		{"age", reflect.TypeOf(Number(0)), Number(19), true},
+		{"age", reflect.TypeOf(true), nil, false},
+		{"score", reflect.TypeOf(float32(0)), float32(1.5), true},
+		{"active", reflect.TypeOf(true), true, true},
+		{"nil", reflect.TypeOf(""), nil, true},
+		{"born", reflect.TypeOf(time.Time{}), born, true},
+		{"tags", reflect.TypeOf([]string{}), []string{"captain", "rubber"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field+" "+tt.typ.String(), func(t *testing.T) {
+			value, valid := p.GetWithType(tt.field, tt.typ)
+			assert.Equal(t, tt.value, value)
+			assert.Equal(t, tt.valid, valid)
+		})
+	}
+
+	address, valid := p.GetParams("address")
+	assert.True(t, valid)
+	assert.Equal(t, "East Blue", address.Get("city"))
+
+	geo, valid := address.GetParams("geo")
+	assert.True(t, valid)
+	lat, valid := geo.GetWithType("lat", reflect.TypeOf(float64(0)))
+	assert.True(t, valid)
+	assert.Equal(t, 1.5, lat)
+
+	crews, valid := p.GetParamsSlice("crews")
+	assert.True(t, valid)
+	assert.Len(t, crews, 2)
+	assert.Equal(t, "Nami", crews[1].Get("name"))
+
+	_, valid = p.GetParamsSlice("address")
+	assert.False(t, valid)
+}
+
+func TestParse_invalid(t *testing.T) {
+	_, err := yaml.Parse([]byte("name: [invalid"))
+	assert.NotNil(t, err)
+
+	_, err = yaml.Parse([]byte("- not\n- a map"))
+	assert.NotNil(t, err)
+
+	_, err = yaml.Parse([]byte("nan: .nan"))
+	assert.NotNil(t, err)
+}
@@ -2,12 +2,14 @@ package changeset
 
 import (
 	"reflect"
-	"strings"
 )
 
 // PutAssocErrorMessage is the default error message for PutAssoc.
 var PutAssocErrorMessage = "{field} is invalid"
 
+// PutAssocErrorKey is the message key of PutAssoc error.
+const PutAssocErrorKey = "put_assoc"
+
 // PutAssoc to changeset.
 func PutAssoc(ch *Changeset, field string, value interface{}, opts ...Option) {
 	options := Options{
@@ -29,6 +31,5 @@ func PutAssoc(ch *Changeset, field string, value interface{}, opts ...Option) {
 			}
 		}
 	}
-	msg := strings.Replace(options.message, "{field}", field, 1)
-	AddError(ch, field, msg)
+	addOptionError(ch, field, PutAssocErrorKey, options, nil)
 }
@@ -2,12 +2,14 @@ package changeset
 
 import (
 	"reflect"
-	"strings"
 )
 
 // PutChangeErrorMessage is the default error message for PutChange.
 var PutChangeErrorMessage = "{field} is invalid"
 
+// PutChangeErrorKey is the message key of PutChange error.
+const PutChangeErrorKey = "put_change"
+
 // PutChange to changeset.
 func PutChange(ch *Changeset, field string, value interface{}, opts ...Option) {
 	options := Options{
@@ -37,6 +39,5 @@ func PutChange(ch *Changeset, field string, value interface{}, opts ...Option) {
 		}
 	}
 
-	msg := strings.Replace(options.message, "{field}", field, 1)
-	AddError(ch, field, msg)
+	addOptionError(ch, field, PutChangeErrorKey, options, nil)
 }
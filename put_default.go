@@ -2,12 +2,14 @@ package changeset
 
 import (
 	"reflect"
-	"strings"
 )
 
 // PutDefaultErrorMessage is the default error message for PutDefault.
 var PutDefaultErrorMessage = "{field} is invalid"
 
+// PutDefaultErrorKey is the message key of PutDefault error.
+const PutDefaultErrorKey = "put_default"
+
 // PutDefault to changeset.
 func PutDefault(ch *Changeset, field string, value interface{}, opts ...Option) {
 	options := Options{
@@ -27,6 +29,5 @@ func PutDefault(ch *Changeset, field string, value interface{}, opts ...Option)
 		}
 	}
 
-	msg := strings.Replace(options.message, "{field}", field, 1)
-	AddError(ch, field, msg)
+	addOptionError(ch, field, PutDefaultErrorKey, options, nil)
 }
@@ -12,14 +12,17 @@ var (
 	fieldsCache       sync.Map
 	fieldMappingCache sync.Map
 	typesCache        sync.Map
+	primaryCache      sync.Map
 )
 
-type fields interface {
+// Fields is implemented by struct that can list its fields without reflection.
+// Fields returns field names mapped to their index in Types and Values.
+type Fields interface {
 	Fields() map[string]int
 }
 
 func inferFields(record interface{}) map[string]int {
-	if s, ok := record.(fields); ok {
+	if s, ok := record.(Fields); ok {
 		return s.Fields()
 	}
 
@@ -105,13 +108,15 @@ func inferFieldMapping(record interface{}) map[string]int {
 	return mapping
 }
 
-type types interface {
-	fields
+// Types is implemented by struct that can list its field types without reflection.
+// Pointer types are dereferenced and slice of pointer are listed as slice of its element.
+type Types interface {
+	Fields
 	Types() []reflect.Type
 }
 
 func inferTypes(record interface{}) []reflect.Type {
-	if v, ok := record.(types); ok {
+	if v, ok := record.(Types); ok {
 		return v.Types()
 	}
 
@@ -148,13 +153,15 @@ func inferTypes(record interface{}) []reflect.Type {
 	return types
 }
 
-type values interface {
-	fields
+// Values is implemented by struct that can list its field values without reflection.
+// Pointer values are dereferenced, nil pointer is listed as nil.
+type Values interface {
+	Fields
 	Values() []interface{}
 }
 
 func inferValues(record interface{}) []interface{} {
-	if v, ok := record.(values); ok {
+	if v, ok := record.(Values); ok {
 		return v.Values()
 	}
 
@@ -183,3 +190,48 @@ func inferValues(record interface{}) []interface{} {
 
 	return values
 }
+
+type primary interface {
+	PrimaryFields() []string
+}
+
+// inferPrimaryField returns name of primary field using the same rule as rel.
+// returns empty string if the struct doesn't have primary field or uses composite primary key.
+func inferPrimaryField(rt reflect.Type) string {
+	// check for cache
+	if v, cached := primaryCache.Load(rt); cached {
+		return v.(string)
+	}
+
+	var (
+		fields   []string
+		fallback string
+	)
+
+	if p, ok := reflect.Zero(rt).Interface().(primary); ok {
+		fields = p.PrimaryFields()
+	} else {
+		for i := 0; i < rt.NumField(); i++ {
+			sf := rt.Field(i)
+
+			if tag := sf.Tag.Get("db"); strings.HasSuffix(tag, ",primary") {
+				fields = append(fields, inferFieldName(sf))
+			} else if strings.EqualFold("id", sf.Name) {
+				fallback = inferFieldName(sf)
+			}
+		}
+	}
+
+	if len(fields) == 0 && fallback != "" {
+		fields = []string{fallback}
+	}
+
+	field := ""
+	if len(fields) == 1 {
+		field = fields[0]
+	}
+
+	primaryCache.Store(rt, field)
+
+	return field
+}
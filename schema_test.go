@@ -8,6 +8,11 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+var (
+	_ Types  = CustomSchema{}
+	_ Values = (*CustomSchema)(nil)
+)
+
 type CustomSchema struct {
 	UUID  string
 	Price int
@@ -0,0 +1,72 @@
+package changeset
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Translator renders localized error message from message key and its arguments.
+type Translator interface {
+	Translate(locale string, key string, args map[string]interface{}) (string, bool)
+}
+
+// Catalog is a Translator that uses message templates grouped by locale and message key.
+// Templates can refer to message arguments using placeholder such as {field}.
+//
+//	catalog := changeset.Catalog{
+//		"id": {
+//			changeset.ValidateRequiredErrorKey: "{field} wajib diisi",
+//		},
+//	}
+type Catalog map[string]map[string]string
+
+// Translate message key to given locale.
+func (c Catalog) Translate(locale string, key string, args map[string]interface{}) (string, bool) {
+	template, ok := c[locale][key]
+	if !ok {
+		return "", false
+	}
+
+	return formatMessage(template, args), true
+}
+
+// Translate returns a copy of error with message rendered by translator for given locale.
+// Error without message key, with message given using Message option or without translation is returned as is.
+func (e Error) Translate(translator Translator, locale string) Error {
+	if e.Key == "" || e.custom {
+		return e
+	}
+
+	if message, ok := translator.Translate(locale, e.Key, e.Args); ok {
+		e.Message = message
+	}
+
+	return e
+}
+
+// TranslateErrors returns errors of changeset with message rendered by translator for given locale.
+func (c Changeset) TranslateErrors(translator Translator, locale string) []error {
+	if c.errors == nil {
+		return nil
+	}
+
+	errs := make([]error, len(c.errors))
+	for i, err := range c.errors {
+		if e, ok := err.(Error); ok {
+			errs[i] = e.Translate(translator, locale)
+		} else {
+			errs[i] = err
+		}
+	}
+
+	return errs
+}
+
+func formatMessage(template string, args map[string]interface{}) string {
+	oldnew := make([]string, 0, len(args)*2)
+	for name, value := range args {
+		oldnew = append(oldnew, "{"+name+"}", fmt.Sprintf("%v", value))
+	}
+
+	return strings.NewReplacer(oldnew...).Replace(template)
+}
@@ -0,0 +1,82 @@
+package changeset
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-rel/rel"
+	"github.com/stretchr/testify/assert"
+)
+
+var testCatalog = Catalog{
+	"id": {
+		ValidateRequiredErrorKey: "{field} wajib diisi",
+		ValidateRangeErrorKey:    "{field} harus di antara {min} dan {max}",
+		UniqueConstraintKey:      "{field} sudah digunakan",
+	},
+}
+
+func TestCatalog_Translate(t *testing.T) {
+	message, ok := testCatalog.Translate("id", ValidateRangeErrorKey, map[string]interface{}{"field": "age", "min": 1, "max": 10})
+	assert.True(t, ok)
+	assert.Equal(t, "age harus di antara 1 dan 10", message)
+
+	_, ok = testCatalog.Translate("id", ValidateMaxErrorKey, nil)
+	assert.False(t, ok)
+
+	_, ok = testCatalog.Translate("fr", ValidateRequiredErrorKey, nil)
+	assert.False(t, ok)
+}
+
+func TestChangeset_TranslateErrors(t *testing.T) {
+	var (
+		plain = errors.New("plain error")
+		ch    = &Changeset{
+			changes: map[string]interface{}{
+				"age":   20,
+				"email": "luffy@example.com",
+			},
+		}
+	)
+
+	ValidateRequired(ch, []string{"name"})
+	ValidateRange(ch, "age", 1, 10)
+	ValidateMax(ch, "email", 5)
+	AddError(ch, "field", "custom")
+	ch.errors = append(ch.errors, plain)
+
+	assert.Equal(t, []error{
+		Error{Message: "name wajib diisi", Field: "name", Key: ValidateRequiredErrorKey, Args: map[string]interface{}{"field": "name"}},
+		Error{Message: "age harus di antara 1 dan 10", Field: "age", Key: ValidateRangeErrorKey, Args: map[string]interface{}{"field": "age", "min": 1, "max": 10}},
+		Error{Message: "email must be less than 5", Field: "email", Key: ValidateMaxErrorKey, Args: map[string]interface{}{"field": "email", "max": 5}},
+		Error{Message: "custom", Field: "field"},
+		plain,
+	}, ch.TranslateErrors(testCatalog, "id"))
+
+	// original errors are untouched.
+	assert.Equal(t, "name is required", ch.Error().Error())
+	assert.Nil(t, Changeset{}.TranslateErrors(testCatalog, "id"))
+}
+
+func TestError_Translate_constraint(t *testing.T) {
+	ch := &Changeset{}
+	UniqueConstraint(ch, "slug")
+
+	err := ch.Constraints().GetError(rel.ConstraintError{Key: "slug_unique_index", Type: rel.UniqueConstraint})
+	assert.Equal(t, "slug has already been taken", err.Error())
+	assert.Equal(t, "slug sudah digunakan", err.(Error).Translate(testCatalog, "id").Error())
+}
+
+func TestError_Translate_customMessage(t *testing.T) {
+	ch := &Changeset{changes: map[string]interface{}{"age": 20}}
+	ValidateRequired(ch, []string{"name"}, Message("name can't be blank"))
+	ValidateRange(ch, "age", 1, 10, Message("age is out of range"))
+	UniqueConstraint(ch, "slug", Message("slug is taken"))
+
+	errs := ch.TranslateErrors(testCatalog, "id")
+	assert.Equal(t, "name can't be blank", errs[0].Error())
+	assert.Equal(t, "age is out of range", errs[1].Error())
+
+	err := ch.Constraints().GetError(rel.ConstraintError{Key: "slug_unique_index", Type: rel.UniqueConstraint})
+	assert.Equal(t, "slug is taken", err.(Error).Translate(testCatalog, "id").Error())
+}
@@ -0,0 +1,124 @@
+package changeset
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/go-rel/changeset/params"
+)
+
+// TypedChangeset is changeset of T, methods of Changeset are available directly,
+// while changeset functions require the embedded Changeset, eg: ValidateRequired(ch.Changeset, fields).
+type TypedChangeset[T any] struct {
+	*Changeset
+	data T
+}
+
+// CastT is type-safe variant of Cast, data must be a struct or pointer to struct.
+// Go constraints can't restrict T to struct types, thus other T compiles but panics when CastT is called.
+//
+//	ch := changeset.CastT(user, params, []string{"name", "age"})
+//	changeset.ValidateRequired(ch.Changeset, []string{"name"})
+//	name, ok := changeset.GetAs[string](ch.Changeset, "name")
+func CastT[T any](data T, params params.Params, fields []string, opts ...Option) *TypedChangeset[T] {
+	rt := reflect.TypeOf((*T)(nil)).Elem()
+	if rt.Kind() == reflect.Interface {
+		rt = reflect.TypeOf(data)
+	}
+
+	if rt == nil || (rt.Kind() != reflect.Struct && (rt.Kind() != reflect.Ptr || rt.Elem().Kind() != reflect.Struct)) {
+		panic("changeset: CastT data must be a struct or pointer to struct, got " + fmt.Sprint(rt))
+	}
+
+	return &TypedChangeset[T]{
+		Changeset: Cast(data, params, fields, opts...),
+		data:      data,
+	}
+}
+
+// Data returns the data used to create the changeset.
+func (c *TypedChangeset[T]) Data() T {
+	return c.data
+}
+
+// GetAs returns a change as V, returns false if the field is not changed or the change is not a V.
+func GetAs[V any](ch *Changeset, field string) (V, bool) {
+	value, ok := ch.Get(field).(V)
+	return value, ok
+}
+
+// FetchAs returns a change or value as V, returns false if both are missing or it's not a V.
+func FetchAs[V any](ch *Changeset, field string) (V, bool) {
+	value, ok := ch.Fetch(field).(V)
+	return value, ok
+}
+
+// ChangeFuncT is type-safe variant of ChangeFunc, used by CastAssocT and CastEmbedT.
+type ChangeFuncT[T any] func(data T, params params.Params) *Changeset
+
+// CastAssocT is type-safe variant of CastAssoc, T must be the struct type of the association.
+// Panics if the association is not a T, *T, []T or []*T.
+func CastAssocT[T any](ch *Changeset, field string, fn ChangeFuncT[T], opts ...Option) {
+	CastAssoc(ch, field, fn.changeFunc(ch, field), opts...)
+}
+
+// CastEmbedT is type-safe variant of CastEmbed, T must be the struct type of the embedded field.
+// Panics if the embedded field is not a T, *T, []T or []*T.
+func CastEmbedT[T any](ch *Changeset, field string, fn ChangeFuncT[T], opts ...Option) {
+	CastEmbed(ch, field, fn.changeFunc(ch, field), opts...)
+}
+
+// changeFunc adapts fn as ChangeFunc after checking that the field type matches T.
+func (fn ChangeFuncT[T]) changeFunc(ch *Changeset, field string) ChangeFunc {
+	rt := reflect.TypeOf((*T)(nil)).Elem()
+
+	if typ, ok := ch.types[field]; ok && !typedCompatible(typ, rt) {
+		panic("changeset: field " + field + " of type " + typ.String() + " can't be casted as " + rt.String())
+	}
+
+	return func(data interface{}, params params.Params) *Changeset {
+		return fn(typedData[T](data, rt), params)
+	}
+}
+
+// typedCompatible returns true if typ is rt, pointer, slice or array of rt.
+func typedCompatible(typ reflect.Type, rt reflect.Type) bool {
+	for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array {
+		typ = typ.Elem()
+	}
+
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	return typ == rt
+}
+
+// typedData converts association data to T, dereferencing or taking address of the data if needed.
+// nil data or nil pointer to T is converted to zero T, panics if data can't be converted to T.
+func typedData[T any](data interface{}, rt reflect.Type) T {
+	if typed, ok := data.(T); ok {
+		return typed
+	}
+
+	var (
+		result T
+		rv     = reflect.ValueOf(data)
+	)
+
+	switch {
+	case !rv.IsValid():
+	case rv.Kind() == reflect.Ptr && rv.Type().Elem() == rt:
+		if !rv.IsNil() {
+			result = rv.Elem().Interface().(T)
+		}
+	case rt.Kind() == reflect.Ptr && rt.Elem() == rv.Type():
+		pv := reflect.New(rv.Type())
+		pv.Elem().Set(rv)
+		result = pv.Interface().(T)
+	default:
+		panic("changeset: data of type " + rv.Type().String() + " can't be casted as " + rt.String())
+	}
+
+	return result
+}
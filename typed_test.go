@@ -0,0 +1,139 @@
+package changeset
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-rel/changeset/params"
+	"github.com/go-rel/rel"
+	"github.com/stretchr/testify/assert"
+)
+
+type TypedOwner struct {
+	ID       int
+	Name     string
+	Inner    Inner
+	Inners   []*Inner
+	Location Location
+}
+
+func changeInnerT(inner Inner, input params.Params) *Changeset {
+	return Cast(inner, input, []string{"field4", "field5"})
+}
+
+func TestCastT(t *testing.T) {
+	owner := TypedOwner{ID: 1, Name: "Luffy"}
+	ch := CastT(owner, params.Map{"name": "Zoro"}, []string{"name"})
+	ValidateRequired(ch.Changeset, []string{"name"})
+
+	assert.Nil(t, ch.Errors())
+	assert.Equal(t, owner, ch.Data())
+
+	name, ok := GetAs[string](ch.Changeset, "name")
+	assert.True(t, ok)
+	assert.Equal(t, "Zoro", name)
+
+	_, ok = GetAs[int](ch.Changeset, "name")
+	assert.False(t, ok)
+
+	_, ok = GetAs[int](ch.Changeset, "id")
+	assert.False(t, ok)
+
+	id, ok := FetchAs[int](ch.Changeset, "id")
+	assert.True(t, ok)
+	assert.Equal(t, 1, id)
+
+	_, ok = FetchAs[string](ch.Changeset, "not_exists")
+	assert.False(t, ok)
+}
+
+func TestCastT_notStruct(t *testing.T) {
+	assert.NotPanics(t, func() {
+		CastT(&TypedOwner{}, params.Map{}, []string{"name"})
+		CastT[any](TypedOwner{}, params.Map{}, []string{"name"})
+	})
+
+	assert.PanicsWithValue(t, "changeset: CastT data must be a struct or pointer to struct, got int", func() {
+		CastT(42, params.Map{}, []string{"name"})
+	})
+
+	assert.PanicsWithValue(t, "changeset: CastT data must be a struct or pointer to struct, got *int", func() {
+		CastT(new(int), params.Map{}, []string{"name"})
+	})
+
+	assert.PanicsWithValue(t, "changeset: CastT data must be a struct or pointer to struct, got string", func() {
+		CastT[any]("luffy", params.Map{}, []string{"name"})
+	})
+}
+
+func TestCastAssocT(t *testing.T) {
+	input := params.Map{
+		"inner":  params.Map{"field4": 4},
+		"inners": []params.Map{{"field5": "5"}},
+	}
+
+	ch := CastT(TypedOwner{ID: 1}, input, []string{"name"})
+	CastAssocT(ch.Changeset, "inner", changeInnerT)
+	CastAssocT(ch.Changeset, "inners", func(inner *Inner, input params.Params) *Changeset {
+		return Cast(inner, input, []string{"field5"})
+	})
+
+	assert.Nil(t, ch.Errors())
+
+	inner, ok := GetAs[*Changeset](ch.Changeset, "inner")
+	assert.True(t, ok)
+	assert.Equal(t, 4, inner.Get("field4"))
+
+	inners, ok := GetAs[[]*Changeset](ch.Changeset, "inners")
+	assert.True(t, ok)
+	assert.Equal(t, "5", inners[0].Get("field5"))
+}
+
+func TestCastEmbedT(t *testing.T) {
+	var (
+		place = Place{ID: 1, Location: Location{Street: "Old Street"}}
+		input = params.Map{"location": params.Map{"city": "Bandung"}}
+	)
+
+	ch := CastT(place, input, []string{"name"})
+	CastEmbedT(ch.Changeset, "location", func(location Location, input params.Params) *Changeset {
+		assert.Equal(t, "Old Street", location.Street)
+		return changeLocation(location, input)
+	})
+
+	assert.Nil(t, ch.Errors())
+
+	rel.Apply(rel.NewDocument(&place), ch.Changeset)
+	assert.Equal(t, Location{Street: "Old Street", City: "Bandung"}, place.Location)
+}
+
+func TestCastAssocT_mismatch(t *testing.T) {
+	ch := CastT(TypedOwner{}, params.Map{}, []string{"name"})
+
+	assert.PanicsWithValue(t, "changeset: field inner of type changeset.Inner can't be casted as changeset.Location", func() {
+		CastAssocT(ch.Changeset, "inner", func(location Location, input params.Params) *Changeset {
+			return Cast(location, input, []string{"city"})
+		})
+	})
+
+	assert.PanicsWithValue(t, "changeset: field location of type changeset.Location can't be casted as changeset.Inner", func() {
+		CastEmbedT(ch.Changeset, "location", changeInnerT)
+	})
+}
+
+func TestTypedData(t *testing.T) {
+	var (
+		inner = Inner{Field4: 4}
+		rt    = reflect.TypeOf(inner)
+	)
+
+	assert.Equal(t, inner, typedData[Inner](inner, rt))
+	assert.Equal(t, inner, typedData[Inner](&inner, rt))
+	assert.Equal(t, Inner{}, typedData[Inner]((*Inner)(nil), rt))
+	assert.Equal(t, Inner{}, typedData[Inner](nil, rt))
+	assert.Equal(t, &inner, typedData[*Inner](inner, reflect.TypeOf(&inner)))
+
+	assert.PanicsWithValue(t, "changeset: data of type changeset.Location can't be casted as changeset.Inner", func() {
+		typedData[Inner](Location{}, rt)
+	})
+}
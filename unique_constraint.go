@@ -9,6 +9,9 @@ import (
 // UniqueConstraintMessage is the default error message for UniqueConstraint.
 var UniqueConstraintMessage = "{field} has already been taken"
 
+// UniqueConstraintKey is the message key of UniqueConstraint error.
+const UniqueConstraintKey = "unique_constraint"
+
 // UniqueConstraint adds an unique constraint to changeset.
 func UniqueConstraint(ch *Changeset, field string, opts ...Option) {
 	options := Options{
@@ -21,9 +24,11 @@ func UniqueConstraint(ch *Changeset, field string, opts ...Option) {
 	ch.constraints = append(ch.constraints, Constraint{
 		Field:   field,
 		Message: strings.Replace(options.message, "{field}", field, 1),
+		Key:     UniqueConstraintKey,
 		Code:    options.code,
 		Name:    options.name,
 		Exact:   options.exact,
+		custom:  options.customMessage,
 		Type:    rel.UniqueConstraint,
 	})
 }
@@ -1,13 +1,11 @@
 package changeset
 
-import (
-	"fmt"
-	"strings"
-)
-
 // ValidateExclusionErrorMessage is the default error message for ValidateExclusion.
 var ValidateExclusionErrorMessage = "{field} must not be any of {values}"
 
+// ValidateExclusionErrorKey is the message key of ValidateExclusion error.
+const ValidateExclusionErrorKey = "validate_exclusion"
+
 // ValidateExclusion validates a change is not included in the given values.
 func ValidateExclusion(ch *Changeset, field string, values []interface{}, opts ...Option) {
 	val, exist := ch.changes[field]
@@ -29,7 +27,6 @@ func ValidateExclusion(ch *Changeset, field string, values []interface{}, opts .
 	}
 
 	if invalid {
-		r := strings.NewReplacer("{field}", field, "{values}", fmt.Sprintf("%v", values))
-		AddError(ch, field, r.Replace(options.message))
+		addOptionError(ch, field, ValidateExclusionErrorKey, options, map[string]interface{}{"values": values})
 	}
 }
@@ -0,0 +1,57 @@
+package changeset
+
+import (
+	"mime/multipart"
+)
+
+// ValidateFileSizeErrorMessage is the default error message for ValidateFileSize.
+var ValidateFileSizeErrorMessage = "{field} must be smaller than {max} bytes"
+
+// ValidateFileSizeErrorKey is the message key of ValidateFileSize error.
+const ValidateFileSizeErrorKey = "validate_file_size"
+
+// ValidateFileSize validates the size of uploaded file in given field is not larger than max bytes.
+// Validation can be performed against multipart.FileHeader and slice of it, every file in slice must be valid.
+func ValidateFileSize(ch *Changeset, field string, max int64, opts ...Option) {
+	val, exist := ch.changes[field]
+	if !exist {
+		return
+	}
+
+	options := Options{
+		message: ValidateFileSizeErrorMessage,
+	}
+	options.apply(opts)
+
+	invalid := false
+	for _, file := range fileHeaders(val) {
+		invalid = invalid || file.Size > max
+	}
+
+	if invalid {
+		addOptionError(ch, field, ValidateFileSizeErrorKey, options, map[string]interface{}{"max": max})
+	}
+}
+
+// fileHeaders returns uploaded files of a change.
+func fileHeaders(val interface{}) []*multipart.FileHeader {
+	switch v := val.(type) {
+	case multipart.FileHeader:
+		return []*multipart.FileHeader{&v}
+	case *multipart.FileHeader:
+		if v != nil {
+			return []*multipart.FileHeader{v}
+		}
+	case []multipart.FileHeader:
+		files := make([]*multipart.FileHeader, len(v))
+		for i := range v {
+			files[i] = &v[i]
+		}
+
+		return files
+	case []*multipart.FileHeader:
+		return v
+	}
+
+	return nil
+}
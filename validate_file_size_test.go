@@ -0,0 +1,86 @@
+package changeset
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"testing"
+
+	"github.com/go-rel/changeset/params"
+	"github.com/stretchr/testify/assert"
+)
+
+type Upload struct {
+	Avatar      *multipart.FileHeader   `ch:"avatar" db:"-"`
+	Attachments []*multipart.FileHeader `ch:"attachments" db:"-"`
+}
+
+func castUpload(t *testing.T, files map[string][]string) *Changeset {
+	var (
+		body   bytes.Buffer
+		writer = multipart.NewWriter(&body)
+	)
+
+	for k, contents := range files {
+		for i, content := range contents {
+			w, err := writer.CreateFormFile(k, fmt.Sprintf("file%d", i))
+			assert.Nil(t, err)
+			w.Write([]byte(content))
+		}
+	}
+
+	assert.Nil(t, writer.Close())
+
+	form, err := multipart.NewReader(&body, writer.Boundary()).ReadForm(1 << 20)
+	assert.Nil(t, err)
+
+	ch := Cast(Upload{}, params.ParseMultipartForm(form), []string{"avatar", "attachments"})
+	assert.Nil(t, ch.Errors())
+
+	return ch
+}
+
+func TestValidateFileSize(t *testing.T) {
+	ch := castUpload(t, map[string][]string{
+		"avatar":      {"12345"},
+		"attachments": {"1", "12"},
+	})
+
+	ValidateFileSize(ch, "avatar", 5)
+	ValidateFileSize(ch, "attachments", 2)
+	ValidateFileSize(ch, "missing", 0)
+	assert.Nil(t, ch.Errors())
+}
+
+func TestValidateFileSize_error(t *testing.T) {
+	ch := castUpload(t, map[string][]string{
+		"avatar":      {"123456"},
+		"attachments": {"1", "123"},
+	})
+
+	ValidateFileSize(ch, "avatar", 5)
+	ValidateFileSize(ch, "attachments", 2, Message("attachment is too large"))
+
+	assert.Equal(t, 2, len(ch.Errors()))
+	assert.Equal(t, Error{
+		Message: "avatar must be smaller than 5 bytes",
+		Field:   "avatar",
+		Key:     ValidateFileSizeErrorKey,
+		Args:    map[string]interface{}{"field": "avatar", "max": int64(5)},
+	}, ch.Errors()[0])
+	assert.Equal(t, "attachment is too large", ch.Errors()[1].Error())
+}
+
+func TestValidateFileSize_pointer(t *testing.T) {
+	ch := &Changeset{
+		changes: map[string]interface{}{
+			"nil":  (*multipart.FileHeader)(nil),
+			"file": &multipart.FileHeader{Size: 10},
+		},
+	}
+
+	ValidateFileSize(ch, "nil", 5)
+	ValidateFileSize(ch, "file", 5)
+	assert.Equal(t, 1, len(ch.Errors()))
+	assert.Equal(t, "file", ch.Error().(Error).Field)
+}
@@ -0,0 +1,75 @@
+package changeset
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+)
+
+// ValidateFileTypeErrorMessage is the default error message for ValidateFileType.
+var ValidateFileTypeErrorMessage = "{field} must be one of {types}"
+
+// ValidateFileTypeErrorKey is the message key of ValidateFileType error.
+const ValidateFileTypeErrorKey = "validate_file_type"
+
+// ValidateFileType validates the MIME type of uploaded file in given field is one of the types.
+// MIME type is detected from file content instead of the one sent by client, type such as image/* matches any subtype.
+// Validation can be performed against multipart.FileHeader and slice of it, every file in slice must be valid.
+func ValidateFileType(ch *Changeset, field string, types []string, opts ...Option) {
+	val, exist := ch.changes[field]
+	if !exist {
+		return
+	}
+
+	options := Options{
+		message: ValidateFileTypeErrorMessage,
+	}
+	options.apply(opts)
+
+	invalid := false
+	for _, file := range fileHeaders(val) {
+		invalid = invalid || !matchFileType(detectFileType(file), types)
+	}
+
+	if invalid {
+		addOptionError(ch, field, ValidateFileTypeErrorKey, options, map[string]interface{}{"types": strings.Join(types, ", ")})
+	}
+}
+
+// detectFileType sniffs MIME type of uploaded file, returns empty string if the file can't be read.
+func detectFileType(file *multipart.FileHeader) string {
+	f, err := file.Open()
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(f, buf)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return ""
+	}
+
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(buf[:n]))
+	if err != nil {
+		return ""
+	}
+
+	return mediaType
+}
+
+func matchFileType(mediaType string, types []string) bool {
+	if mediaType == "" {
+		return false
+	}
+
+	for _, typ := range types {
+		if typ == mediaType || (strings.HasSuffix(typ, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(typ, "*"))) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,41 @@
+package changeset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pngContent = "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"
+
+func TestValidateFileType(t *testing.T) {
+	ch := castUpload(t, map[string][]string{
+		"avatar":      {pngContent},
+		"attachments": {"plain text", pngContent},
+	})
+
+	ValidateFileType(ch, "avatar", []string{"image/png"})
+	ValidateFileType(ch, "avatar", []string{"image/*"})
+	ValidateFileType(ch, "attachments", []string{"text/plain", "image/*"})
+	ValidateFileType(ch, "missing", []string{"image/png"})
+	assert.Nil(t, ch.Errors())
+}
+
+func TestValidateFileType_error(t *testing.T) {
+	ch := castUpload(t, map[string][]string{
+		"avatar":      {"<html><body></body></html>"},
+		"attachments": {pngContent, "plain text"},
+	})
+
+	ValidateFileType(ch, "avatar", []string{"image/png", "image/jpeg"})
+	ValidateFileType(ch, "attachments", []string{"image/*"}, Message("attachments must be images"))
+
+	assert.Equal(t, 2, len(ch.Errors()))
+	assert.Equal(t, Error{
+		Message: "avatar must be one of image/png, image/jpeg",
+		Field:   "avatar",
+		Key:     ValidateFileTypeErrorKey,
+		Args:    map[string]interface{}{"field": "avatar", "types": "image/png, image/jpeg"},
+	}, ch.Errors()[0])
+	assert.Equal(t, "attachments must be images", ch.Errors()[1].Error())
+}
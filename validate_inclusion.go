@@ -1,13 +1,11 @@
 package changeset
 
-import (
-	"fmt"
-	"strings"
-)
-
 // ValidateInclusionErrorMessage is the default error message for ValidateInclusion.
 var ValidateInclusionErrorMessage = "{field} must be one of {values}"
 
+// ValidateInclusionErrorKey is the message key of ValidateInclusion error.
+const ValidateInclusionErrorKey = "validate_inclusion"
+
 // ValidateInclusion validates a change is included in the given values.
 func ValidateInclusion(ch *Changeset, field string, values []interface{}, opts ...Option) {
 	val, exist := ch.changes[field]
@@ -29,7 +27,6 @@ func ValidateInclusion(ch *Changeset, field string, values []interface{}, opts .
 	}
 
 	if invalid {
-		r := strings.NewReplacer("{field}", field, "{values}", fmt.Sprintf("%v", values))
-		AddError(ch, field, r.Replace(options.message))
+		addOptionError(ch, field, ValidateInclusionErrorKey, options, map[string]interface{}{"values": values})
 	}
 }
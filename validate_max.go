@@ -1,13 +1,11 @@
 package changeset
 
-import (
-	"strconv"
-	"strings"
-)
-
 // ValidateMaxErrorMessage is the default error message for ValidateMax.
 var ValidateMaxErrorMessage = "{field} must be less than {max}"
 
+// ValidateMaxErrorKey is the message key of ValidateMax error.
+const ValidateMaxErrorKey = "validate_max"
+
 // ValidateMax validates the value of given field is not larger than max.
 // Validation can be performed against string, slice and numbers.
 func ValidateMax(ch *Changeset, field string, max int, opts ...Option) {
@@ -59,7 +57,6 @@ func ValidateMax(ch *Changeset, field string, max int, opts ...Option) {
 	}
 
 	if invalid {
-		r := strings.NewReplacer("{field}", field, "{max}", strconv.Itoa(max))
-		AddError(ch, field, r.Replace(options.message))
+		addOptionError(ch, field, ValidateMaxErrorKey, options, map[string]interface{}{"max": max})
 	}
 }
@@ -1,13 +1,11 @@
 package changeset
 
-import (
-	"strconv"
-	"strings"
-)
-
 // ValidateMinErrorMessage is the default error message for ValidateMin.
 var ValidateMinErrorMessage = "{field} must be more than {min}"
 
+// ValidateMinErrorKey is the message key of ValidateMin error.
+const ValidateMinErrorKey = "validate_min"
+
 // ValidateMin validates the value of given field is not smaller than min.
 // Validation can be performed against string, slice and numbers.
 func ValidateMin(ch *Changeset, field string, min int, opts ...Option) {
@@ -59,7 +57,6 @@ func ValidateMin(ch *Changeset, field string, min int, opts ...Option) {
 	}
 
 	if invalid {
-		r := strings.NewReplacer("{field}", field, "{min}", strconv.Itoa(min))
-		AddError(ch, field, r.Replace(options.message))
+		addOptionError(ch, field, ValidateMinErrorKey, options, map[string]interface{}{"min": min})
 	}
 }
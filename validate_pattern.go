@@ -2,12 +2,14 @@ package changeset
 
 import (
 	"regexp"
-	"strings"
 )
 
 // ValidatePatternErrorMessage is the default error message for ValidatePattern.
 var ValidatePatternErrorMessage = "{field}'s format is invalid"
 
+// ValidatePatternErrorKey is the message key of ValidatePattern error.
+const ValidatePatternErrorKey = "validate_pattern"
+
 // ValidatePattern validates the value of given field to match given pattern.
 func ValidatePattern(ch *Changeset, field string, pattern string, opts ...Option) {
 	val, exist := ch.changes[field]
@@ -23,8 +25,7 @@ func ValidatePattern(ch *Changeset, field string, pattern string, opts ...Option
 	if str, ok := val.(string); ok {
 		match, _ := regexp.MatchString(pattern, str)
 		if !match {
-			msg := strings.Replace(options.message, "{field}", field, 1)
-			AddError(ch, field, msg)
+			addOptionError(ch, field, ValidatePatternErrorKey, options, map[string]interface{}{"pattern": pattern})
 		}
 		return
 	}
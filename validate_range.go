@@ -1,13 +1,11 @@
 package changeset
 
-import (
-	"strconv"
-	"strings"
-)
-
 // ValidateRangeErrorMessage is the default error message for ValidateRange.
 var ValidateRangeErrorMessage = "{field} must be between {min} and {max}"
 
+// ValidateRangeErrorKey is the message key of ValidateRange error.
+const ValidateRangeErrorKey = "validate_range"
+
 // ValidateRange validates the value of given field is not larger than max and not smaller than min.
 // Validation can be performed against string, slice and numbers.
 func ValidateRange(ch *Changeset, field string, min int, max int, opts ...Option) {
@@ -57,7 +55,6 @@ func ValidateRange(ch *Changeset, field string, min int, max int, opts ...Option
 	}
 
 	if invalid {
-		r := strings.NewReplacer("{field}", field, "{min}", strconv.Itoa(min), "{max}", strconv.Itoa(max))
-		AddError(ch, field, r.Replace(options.message))
+		addOptionError(ch, field, ValidateRangeErrorKey, options, map[string]interface{}{"min": min, "max": max})
 	}
 }
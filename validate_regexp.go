@@ -2,12 +2,14 @@ package changeset
 
 import (
 	"regexp"
-	"strings"
 )
 
 // ValidateRegexpErrorMessage is the default error message for ValidateRegexp.
 var ValidateRegexpErrorMessage = "{field}'s format is invalid"
 
+// ValidateRegexpErrorKey is the message key of ValidateRegexp error.
+const ValidateRegexpErrorKey = "validate_regexp"
+
 // ValidateRegexp validates the value of given field to match given regexp.
 func ValidateRegexp(ch *Changeset, field string, exp *regexp.Regexp, opts ...Option) {
 	val, exist := ch.changes[field]
@@ -23,8 +25,7 @@ func ValidateRegexp(ch *Changeset, field string, exp *regexp.Regexp, opts ...Opt
 	if str, ok := val.(string); ok {
 		match := exp.MatchString(str)
 		if !match {
-			msg := strings.Replace(options.message, "{field}", field, 1)
-			AddError(ch, field, msg)
+			addOptionError(ch, field, ValidateRegexpErrorKey, options, map[string]interface{}{"pattern": exp.String()})
 		}
 		return
 	}
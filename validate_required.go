@@ -7,6 +7,9 @@ import (
 // ValidateRequiredErrorMessage is the default error message for ValidateRequired.
 var ValidateRequiredErrorMessage = "{field} is required"
 
+// ValidateRequiredErrorKey is the message key of ValidateRequired error.
+const ValidateRequiredErrorKey = "validate_required"
+
 // isZeroer is the interface that wraps the basic isZero method.
 type isZeroer interface {
 	IsZero() bool
@@ -43,7 +46,6 @@ func ValidateRequired(ch *Changeset, fields []string, opts ...Option) {
 			continue
 		}
 
-		msg := strings.Replace(options.message, "{field}", f, 1)
-		AddError(ch, f, msg)
+		addOptionError(ch, f, ValidateRequiredErrorKey, options, nil)
 	}
 }